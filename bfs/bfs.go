@@ -0,0 +1,260 @@
+// Contains algos and logic related to breadth-first graph traversal.
+package bfs
+
+import (
+	"errors"
+
+	"github.com/sdboyer/gogl"
+	"github.com/sdboyer/gogl/dfs"
+)
+
+// Performs a breadth-first search for the given target vertex in the provided graph, beginning
+// from the given start vertex.
+//
+// Because vertices are visited in order of their distance from the start vertex, the returned
+// path is guaranteed to contain the fewest possible hops. As with dfs.Search, the path is ordered
+// from the target vertex back to the start vertex.
+//
+// If no path can be found, the returned slice is nil and an error is returned instead.
+func Search(g gogl.Graph, target gogl.Vertex, start gogl.Vertex) (path []gogl.Vertex, err error) {
+	if !g.HasVertex(target) {
+		return nil, errors.New("Target vertex is not present in graph.")
+	}
+	if !g.HasVertex(start) {
+		return nil, errors.New("Start vertex is not present in graph.")
+	}
+
+	parents := map[gogl.Vertex]gogl.Vertex{start: nil}
+	neighbors := neighborEnumerator(g)
+
+	queue := vqueue{}
+	queue.push(start)
+
+	var found bool
+	if start == target {
+		found = true
+	}
+
+	for queue.length() > 0 && !found {
+		v := queue.pop()
+		neighbors(v, func(adj gogl.Vertex) bool {
+			if _, seen := parents[adj]; !seen {
+				parents[adj] = v
+				if adj == target {
+					found = true
+				} else {
+					queue.push(adj)
+				}
+			}
+			return found
+		})
+	}
+
+	if !found {
+		return nil, errors.New("No path exists from the start vertex to the target vertex.")
+	}
+
+	for v := target; ; v = parents[v] {
+		path = append(path, v)
+		if v == start {
+			break
+		}
+	}
+
+	return path, nil
+}
+
+// Calculates the level of every vertex reachable from the provided start vertices. The
+// level of a vertex is its distance, in hops, from the nearest start vertex; start
+// vertices themselves are at level 0.
+//
+// Vertices that are unreachable from the start set are not included in the returned map.
+//
+// If no starting vertices are provided, then a list of source vertices is built via dfs.FindSources(),
+// and that set is used as the starting point. Because source vertices only exist in a Digraph,
+// an error will be returned if a non-directed graph is provided without any start vertices.
+func Levels(g gogl.Graph, start ...gogl.Vertex) (map[gogl.Vertex]int, error) {
+	vis := &levelVisitor{levels: make(map[gogl.Vertex]int)}
+
+	if _, err := Traverse(g, vis, start...); err != nil {
+		return nil, err
+	}
+
+	return vis.levels, nil
+}
+
+// Traverses the given graph in a breadth-first manner, using the given visitor
+// and starting from the given vertices.
+//
+// All start vertices are placed on the initial queue, and are thus at level 0.
+func Traverse(g gogl.Graph, visitor Visitor, start ...gogl.Vertex) (Visitor, error) {
+	start, err := buildStartQueue(g, start...)
+	if err != nil {
+		return nil, err
+	}
+
+	w := &walker{
+		vis:    visitor,
+		g:      g,
+		levels: make(map[gogl.Vertex]int),
+	}
+
+	if dg, ok := g.(gogl.Digraph); ok {
+		w.dg = dg
+	}
+
+	w.bftraverse(start)
+
+	return visitor, nil
+}
+
+// Simple helper for shared traversal entry-point logic.
+func buildStartQueue(g gogl.Graph, v ...gogl.Vertex) (start []gogl.Vertex, err error) {
+	if len(v) == 0 {
+		if dg, ok := g.(gogl.Digraph); ok {
+			start, err = dfs.FindSources(dg)
+		} else {
+			return nil, errors.New("Undirected graphs do not have sources, a start point for traversal must be provided.")
+		}
+	} else {
+		for _, vtx := range v {
+			if !g.HasVertex(vtx) {
+				return nil, errors.New("Start vertex is not present in graph.")
+			}
+		}
+		start = v
+	}
+
+	return
+}
+
+// Returns the function used to enumerate the vertices reachable in one hop from
+// a given vertex: successors in a digraph, adjacent vertices in an undirected graph.
+func neighborEnumerator(g gogl.Graph) func(gogl.Vertex, gogl.VertexStep) {
+	if dg, ok := g.(gogl.Digraph); ok {
+		return dg.SuccessorsOf
+	}
+	return g.AdjacentTo
+}
+
+// A Visitor is notified of events as a breadth-first traversal proceeds.
+type Visitor interface {
+	// Called when an edge leads to a vertex that has already been discovered.
+	OnSeenVertex(vertex gogl.Vertex)
+	// Called when a vertex is taken from the queue, along with its level.
+	OnStartVertex(vertex gogl.Vertex, level int)
+	// Called for each edge leaving the vertex currently being processed.
+	OnExamineEdge(edge gogl.Edge)
+	// Called when all of a vertex's edges have been examined.
+	OnFinishVertex(vertex gogl.Vertex)
+}
+
+type levelVisitor struct {
+	levels map[gogl.Vertex]int
+}
+
+func (vis *levelVisitor) OnSeenVertex(vertex gogl.Vertex) {}
+
+func (vis *levelVisitor) OnStartVertex(vertex gogl.Vertex, level int) {
+	vis.levels[vertex] = level
+}
+
+func (vis *levelVisitor) OnExamineEdge(edge gogl.Edge) {}
+
+func (vis *levelVisitor) OnFinishVertex(vertex gogl.Vertex) {}
+
+type walker struct {
+	vis    Visitor
+	g      gogl.Graph
+	dg     gogl.Digraph
+	levels map[gogl.Vertex]int
+}
+
+func (w *walker) bftraverse(start []gogl.Vertex) {
+	queue := vqueue{}
+	for _, v := range start {
+		if _, seen := w.levels[v]; !seen {
+			w.levels[v] = 0
+			queue.push(v)
+		}
+	}
+
+	for queue.length() > 0 {
+		v := queue.pop()
+		level := w.levels[v]
+		w.vis.OnStartVertex(v, level)
+
+		visit := func(e gogl.Edge, adj gogl.Vertex) {
+			w.vis.OnExamineEdge(e)
+			if _, seen := w.levels[adj]; seen {
+				w.vis.OnSeenVertex(adj)
+			} else {
+				w.levels[adj] = level + 1
+				queue.push(adj)
+			}
+		}
+
+		if w.dg != nil {
+			w.dg.ArcsFrom(v, func(a gogl.Arc) (terminate bool) {
+				visit(a, a.Target())
+				return
+			})
+		} else {
+			w.g.IncidentTo(v, func(e gogl.Edge) (terminate bool) {
+				u, t := e.Both()
+				if u == v {
+					visit(e, t)
+				} else {
+					visit(e, u)
+				}
+				return
+			})
+		}
+
+		w.vis.OnFinishVertex(v)
+	}
+}
+
+type vnode struct {
+	v    gogl.Vertex
+	next *vnode
+}
+
+type vqueue struct {
+	front *vnode
+	back  *vnode
+	count int
+}
+
+func (q *vqueue) push(v gogl.Vertex) {
+	n := &vnode{v: v}
+
+	if q.back == nil {
+		q.front = n
+		q.back = n
+	} else {
+		q.back.next = n
+		q.back = n
+	}
+
+	q.count++
+}
+
+func (q *vqueue) pop() gogl.Vertex {
+	if q.front == nil {
+		return nil
+	}
+
+	ret := q.front
+	q.front = q.front.next
+	if q.front == nil {
+		q.back = nil
+	}
+
+	q.count--
+	return ret.v
+}
+
+func (q *vqueue) length() int {
+	return q.count
+}
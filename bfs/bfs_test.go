@@ -0,0 +1,181 @@
+package bfs
+
+import (
+	"testing"
+
+	. "github.com/sdboyer/gocheck"
+	"github.com/sdboyer/gogl"
+	"github.com/sdboyer/gogl/graph/al"
+)
+
+// Hook gocheck into the go test runner
+func Test(t *testing.T) { TestingT(t) }
+
+// A diamond with a long way around; the shortest path from foo to qux is two hops.
+var bfArcSet = gogl.ArcList{
+	gogl.NewArc("foo", "bar"),
+	gogl.NewArc("bar", "baz"),
+	gogl.NewArc("baz", "quark"),
+	gogl.NewArc("quark", "qux"),
+	gogl.NewArc("foo", "bix"),
+	gogl.NewArc("bix", "qux"),
+}
+
+var bfEdgeSet = gogl.EdgeList{
+	gogl.NewEdge("foo", "bar"),
+	gogl.NewEdge("bar", "baz"),
+	gogl.NewEdge("baz", "quark"),
+	gogl.NewEdge("quark", "qux"),
+	gogl.NewEdge("foo", "bix"),
+	gogl.NewEdge("bix", "qux"),
+}
+
+type BreadthFirstSearchSuite struct{}
+
+var _ = Suite(&BreadthFirstSearchSuite{})
+
+func (s *BreadthFirstSearchSuite) TestSearch(c *C) {
+	g := gogl.Spec().Directed().Using(bfArcSet).Create(al.G)
+
+	path, err := Search(g, "qux", "foo")
+	c.Assert(err, IsNil)
+	c.Assert(path, DeepEquals, []gogl.Vertex{"qux", "bix", "foo"})
+
+	path, err = Search(g, "foo", "foo")
+	c.Assert(err, IsNil)
+	c.Assert(path, DeepEquals, []gogl.Vertex{"foo"})
+
+	// arcs only go one way
+	path, err = Search(g, "foo", "qux")
+	c.Assert(path, IsNil)
+	c.Assert(err, ErrorMatches, "No path exists.*")
+
+	ug := gogl.Spec().Using(bfEdgeSet).Create(al.G)
+
+	path, err = Search(ug, "foo", "qux")
+	c.Assert(err, IsNil)
+	c.Assert(path, DeepEquals, []gogl.Vertex{"foo", "bix", "qux"})
+}
+
+func (s *BreadthFirstSearchSuite) TestSearchVertexVerification(c *C) {
+	g := gogl.Spec().Mutable().Directed().
+		Create(al.G).(gogl.MutableDigraph)
+	g.EnsureVertex("foo")
+
+	_, err := Search(g.(gogl.Digraph), "foo", "bar")
+	c.Assert(err, ErrorMatches, "Start vertex.*")
+	_, err = Search(g.(gogl.Digraph), "bar", "foo")
+	c.Assert(err, ErrorMatches, "Target vertex.*")
+}
+
+func (s *BreadthFirstSearchSuite) TestLevels(c *C) {
+	g := gogl.Spec().Directed().Using(bfArcSet).Create(al.G)
+
+	levels, err := Levels(g, "foo")
+	c.Assert(err, IsNil)
+	c.Assert(levels, DeepEquals, map[gogl.Vertex]int{
+		"foo":   0,
+		"bar":   1,
+		"bix":   1,
+		"baz":   2,
+		"qux":   2,
+		"quark": 3,
+	})
+
+	// no start vertices on a digraph uses the sources
+	levels, err = Levels(g)
+	c.Assert(err, IsNil)
+	c.Assert(levels["quark"], Equals, 3)
+
+	levels, err = Levels(g, "baz")
+	c.Assert(err, IsNil)
+	c.Assert(levels, DeepEquals, map[gogl.Vertex]int{"baz": 0, "quark": 1, "qux": 2})
+
+	ug := gogl.Spec().Using(bfEdgeSet).Create(al.G)
+
+	_, err = Levels(ug)
+	c.Assert(err, ErrorMatches, ".*do not have sources.*")
+
+	levels, err = Levels(ug, "baz", "bix")
+	c.Assert(err, IsNil)
+	c.Assert(levels, DeepEquals, map[gogl.Vertex]int{
+		"baz":   0,
+		"bix":   0,
+		"bar":   1,
+		"quark": 1,
+		"foo":   1,
+		"qux":   1,
+	})
+
+	_, err = Levels(ug, "nope")
+	c.Assert(err, ErrorMatches, "Start vertex.*")
+}
+
+type countingVisitor struct {
+	started  []gogl.Vertex
+	levels   []int
+	seen     int
+	examined int
+	finished int
+}
+
+func (v *countingVisitor) OnSeenVertex(vertex gogl.Vertex) {
+	v.seen++
+}
+
+func (v *countingVisitor) OnStartVertex(vertex gogl.Vertex, level int) {
+	v.started = append(v.started, vertex)
+	v.levels = append(v.levels, level)
+}
+
+func (v *countingVisitor) OnExamineEdge(edge gogl.Edge) {
+	v.examined++
+}
+
+func (v *countingVisitor) OnFinishVertex(vertex gogl.Vertex) {
+	v.finished++
+}
+
+func (s *BreadthFirstSearchSuite) TestTraverse(c *C) {
+	g := gogl.Spec().Directed().Using(bfArcSet).Create(al.G)
+
+	vis := &countingVisitor{}
+	_, err := Traverse(g, vis, "foo")
+	c.Assert(err, IsNil)
+
+	c.Assert(len(vis.started), Equals, 6)
+	c.Assert(vis.finished, Equals, 6)
+	c.Assert(vis.examined, Equals, len(bfArcSet))
+	// qux is reached twice, so one examination hits an already-seen vertex
+	c.Assert(vis.seen, Equals, 1)
+
+	// levels must be handed out in non-decreasing order
+	for i := 1; i < len(vis.levels); i++ {
+		c.Assert(vis.levels[i] >= vis.levels[i-1], Equals, true)
+	}
+	c.Assert(vis.started[0], Equals, "foo")
+}
+
+type QueueSuite struct{}
+
+var _ = Suite(&QueueSuite{})
+
+func (s *QueueSuite) TestQueue(c *C) {
+	queue := vqueue{}
+
+	c.Assert(queue.length(), Equals, 0)
+
+	queue.push("foo")
+	c.Assert(queue.length(), Equals, 1)
+
+	queue.push("bar")
+	c.Assert(queue.length(), Equals, 2)
+	c.Assert(queue.pop(), Equals, "foo")
+	c.Assert(queue.pop(), Equals, "bar")
+	c.Assert(queue.pop(), IsNil)
+	c.Assert(queue.length(), Equals, 0)
+
+	// queue must remain usable after being drained
+	queue.push("baz")
+	c.Assert(queue.pop(), Equals, "baz")
+}
@@ -153,12 +153,14 @@ func (b GraphSpec) Immutable() GraphSpec {
 }
 
 // Specify that the graph is persistent.
-// TODO Commented out until this actually gets implemented
-//func (b GraphSpec) Persistent() GraphSpec {
-//b.Props &^= G_IMMUTABLE
-//b.Props |= G_PERSISTENT
-//return b
-//}
+//
+// A persistent graph is never modified in place; instead, each mutation returns a new
+// version of the graph that shares structure with the old one, which remains valid.
+func (b GraphSpec) Persistent() GraphSpec {
+	b.Props &^= G_IMMUTABLE
+	b.Props |= G_PERSISTENT
+	return b
+}
 
 // Creates a graph from the spec, using the provided creator function.
 //
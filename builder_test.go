@@ -90,4 +90,11 @@ func (s *SpecTestSuite) TestMutators(c *C) {
 		c.Assert(spec.Immutable().Props&G_IMMUTABLE == G_IMMUTABLE, Equals, true)
 		c.Assert(spec.Immutable().Props&G_MUTABLE == 0, Equals, true)
 	}
+
+	for _, spec.Props = range s.permuteField() {
+		c.Assert(spec.Persistent().Props&G_PERSISTENT == G_PERSISTENT, Equals, true)
+		c.Assert(spec.Persistent().Props&G_IMMUTABLE == 0, Equals, true)
+		c.Assert(spec.Persistent().Mutable().Props&G_PERSISTENT == G_MUTABLE, Equals, true)
+		c.Assert(spec.Persistent().Immutable().Props&G_PERSISTENT == 0, Equals, true)
+	}
 }
@@ -0,0 +1,64 @@
+package centrality
+
+import (
+	"github.com/sdboyer/gogl"
+)
+
+// Calculates the betweenness centrality of every vertex in the provided graph, using
+// Brandes' algorithm: for each pair of other vertices s and t, the fraction of shortest
+// paths from s to t that pass through the vertex, summed over all such pairs.
+//
+// For undirected graphs, each unordered pair is counted once. The result is not
+// normalized. Brandes' algorithm runs in O(VE) time.
+func Betweenness(g gogl.Graph) map[gogl.Vertex]float64 {
+	ix, _ := index(g, false, false)
+	return ix.scores(ix.betweenness(true))
+}
+
+// Calculates betweenness centrality as per Betweenness, but with path lengths measured
+// by the sum of their edge weights. Weights must be non-negative; an error is returned
+// if a negative weight is encountered.
+//
+// Runs in O(VE + V^2 log V) time.
+func WeightedBetweenness(g gogl.WeightedGraph) (map[gogl.Vertex]float64, error) {
+	ix, err := index(g, true, true)
+	if err != nil {
+		return nil, err
+	}
+
+	return ix.scores(ix.betweenness(false)), nil
+}
+
+func (ix *indexed) betweenness(unweighted bool) []float64 {
+	n := len(ix.vertices)
+	cb := make([]float64, n)
+	delta := make([]float64, n)
+	r := newSPResult(n)
+
+	for s := 0; s < n; s++ {
+		ix.shortestPaths(s, unweighted, r)
+
+		// Accumulate dependencies in order of non-increasing distance from s.
+		for _, w := range r.order {
+			delta[w] = 0
+		}
+		for i := len(r.order) - 1; i >= 0; i-- {
+			w := r.order[i]
+			for _, v := range r.preds[w] {
+				delta[v] += r.sigma[v] / r.sigma[w] * (1 + delta[w])
+			}
+			if w != s {
+				cb[w] += delta[w]
+			}
+		}
+	}
+
+	// Every path in an undirected graph is found once from each end.
+	if !ix.directed {
+		for i := range cb {
+			cb[i] /= 2
+		}
+	}
+
+	return cb
+}
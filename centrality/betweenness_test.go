@@ -0,0 +1,78 @@
+package centrality
+
+import (
+	. "github.com/sdboyer/gocheck"
+	"github.com/sdboyer/gogl"
+	"github.com/sdboyer/gogl/graph/al"
+)
+
+type BetweennessSuite struct{}
+
+var _ = Suite(&BetweennessSuite{})
+
+func (s *BetweennessSuite) TestBetweenness(c *C) {
+	g := gogl.Spec().Using(pathEdgeSet).Create(al.G)
+	checkScores(c, Betweenness(g), map[gogl.Vertex]float64{
+		"a": 0, "b": 3, "c": 4, "d": 3, "e": 0,
+	})
+
+	// every pair of spokes routes through the hub
+	g = gogl.Spec().Using(starEdgeSet).Create(al.G)
+	checkScores(c, Betweenness(g), map[gogl.Vertex]float64{
+		"hub": 6, "a": 0, "b": 0, "c": 0, "d": 0,
+	})
+
+	// a square has two shortest paths between opposite corners, splitting the credit
+	g = gogl.Spec().Using(gogl.EdgeList{
+		gogl.NewEdge("a", "b"),
+		gogl.NewEdge("b", "c"),
+		gogl.NewEdge("c", "d"),
+		gogl.NewEdge("d", "a"),
+	}).Create(al.G)
+	checkScores(c, Betweenness(g), map[gogl.Vertex]float64{
+		"a": 0.5, "b": 0.5, "c": 0.5, "d": 0.5,
+	})
+}
+
+func (s *BetweennessSuite) TestBetweennessDirected(c *C) {
+	g := gogl.Spec().Directed().Using(gogl.ArcList{
+		gogl.NewArc("a", "b"),
+		gogl.NewArc("b", "c"),
+		gogl.NewArc("c", "d"),
+		gogl.NewArc("d", "b"),
+	}).Create(al.G)
+
+	// paths through b: a-c, a-d, d-c; through c: a-d, b-d; through d: c-b
+	checkScores(c, Betweenness(g), map[gogl.Vertex]float64{
+		"a": 0, "b": 3, "c": 2, "d": 1,
+	})
+}
+
+func (s *BetweennessSuite) TestWeightedBetweenness(c *C) {
+	g := gogl.Spec().Weighted().Using(gogl.WeightedEdgeList{
+		gogl.NewWeightedEdge("a", "b", 1),
+		gogl.NewWeightedEdge("b", "c", 1),
+		gogl.NewWeightedEdge("a", "c", 5),
+		gogl.NewWeightedEdge("c", "d", 1),
+	}).Create(al.G).(gogl.WeightedGraph)
+
+	scores, err := WeightedBetweenness(g)
+	c.Assert(err, IsNil)
+	checkScores(c, scores, map[gogl.Vertex]float64{
+		"a": 0, "b": 2, "c": 2, "d": 0,
+	})
+
+	// without weights, the direct edge from a to c bypasses b
+	checkScores(c, Betweenness(g), map[gogl.Vertex]float64{
+		"a": 0, "b": 0, "c": 2, "d": 0,
+	})
+
+	_, err = WeightedBetweenness(unweighted{g})
+	c.Assert(err, ErrorMatches, "Graph contains an edge that is not weighted.")
+
+	neg := gogl.Spec().Weighted().Using(gogl.WeightedEdgeList{
+		gogl.NewWeightedEdge("a", "b", -1),
+	}).Create(al.G).(gogl.WeightedGraph)
+	_, err = WeightedBetweenness(neg)
+	c.Assert(err, ErrorMatches, "Negative edge weight encountered .*")
+}
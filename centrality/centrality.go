@@ -0,0 +1,213 @@
+// Contains algos and logic for measuring the centrality - the relative importance - of
+// the vertices in a graph.
+//
+// Every measure returns a map from each vertex in the graph to its score. Unless noted
+// otherwise, digraphs are measured along the direction of their arcs, while undirected
+// graphs treat every edge as traversable in either direction.
+//
+// Weighted variants read edge weights by type asserting to WeightedEdge, and return an
+// error if any edge in the graph is not weighted.
+package centrality
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/sdboyer/gogl"
+)
+
+// Describes the outcome of an iterative measure.
+type Convergence struct {
+	// Whether the scores settled to within the requested tolerance.
+	Converged bool
+	// The number of iterations performed.
+	Iterations int
+	// The sum of the absolute changes in score made by the final iteration.
+	Residual float64
+}
+
+// Calculates the degree centrality of every vertex in the provided graph: the number
+// of edges incident to the vertex, divided by the number of other vertices in the graph.
+//
+// For a digraph, both incoming and outgoing arcs are counted; see InDegree and OutDegree
+// to count only one or the other.
+func Degree(g gogl.Graph) map[gogl.Vertex]float64 {
+	return degree(g, func(count func(gogl.Vertex)) {
+		g.Edges(func(e gogl.Edge) (terminate bool) {
+			u, v := e.Both()
+			count(u)
+			count(v)
+			return
+		})
+	})
+}
+
+// Calculates the in-degree centrality of every vertex in the provided digraph: the
+// number of arcs to the vertex, divided by the number of other vertices in the graph.
+func InDegree(g gogl.Digraph) map[gogl.Vertex]float64 {
+	return degree(g, func(count func(gogl.Vertex)) {
+		g.Arcs(func(a gogl.Arc) (terminate bool) {
+			count(a.Target())
+			return
+		})
+	})
+}
+
+// Calculates the out-degree centrality of every vertex in the provided digraph: the
+// number of arcs from the vertex, divided by the number of other vertices in the graph.
+func OutDegree(g gogl.Digraph) map[gogl.Vertex]float64 {
+	return degree(g, func(count func(gogl.Vertex)) {
+		g.Arcs(func(a gogl.Arc) (terminate bool) {
+			count(a.Source())
+			return
+		})
+	})
+}
+
+// Normalizes the counts of vertex occurrences made by the provided enumerator.
+func degree(g gogl.Graph, enumerate func(count func(gogl.Vertex))) map[gogl.Vertex]float64 {
+	scores := make(map[gogl.Vertex]float64)
+	g.Vertices(func(v gogl.Vertex) (terminate bool) {
+		scores[v] = 0
+		return
+	})
+
+	if len(scores) < 2 {
+		return scores
+	}
+
+	norm := 1 / float64(len(scores)-1)
+	enumerate(func(v gogl.Vertex) {
+		scores[v] += norm
+	})
+
+	return scores
+}
+
+// Calculates the weighted degree, or strength, of every vertex in the provided graph:
+// the sum of the weights of all the edges incident to the vertex. Unlike Degree, the
+// result is not normalized.
+//
+// For a digraph, both incoming and outgoing arcs are counted.
+func WeightedDegree(g gogl.WeightedGraph) (map[gogl.Vertex]float64, error) {
+	scores := make(map[gogl.Vertex]float64)
+	g.Vertices(func(v gogl.Vertex) (terminate bool) {
+		scores[v] = 0
+		return
+	})
+
+	var err error
+	g.Edges(func(e gogl.Edge) (terminate bool) {
+		we, ok := e.(gogl.WeightedEdge)
+		if !ok {
+			err = errors.New("Graph contains an edge that is not weighted.")
+			return true
+		}
+
+		u, v := e.Both()
+		scores[u] += we.Weight()
+		scores[v] += we.Weight()
+		return
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return scores, nil
+}
+
+// An arc between dense vertex indices.
+type indexedArc struct {
+	to int
+	w  float64
+}
+
+// A dense, index-based snapshot of a graph's adjacency structure, shared by the
+// traversal-based and iterative measures.
+type indexed struct {
+	vertices []gogl.Vertex
+	index    map[gogl.Vertex]int
+	// Outgoing and incoming arcs of each vertex. For undirected graphs, each edge appears
+	// as an arc in both directions (a loop appears only once), and in and out are the same.
+	out, in  [][]indexedArc
+	directed bool
+}
+
+// Builds an indexed snapshot of the provided graph. If weighted is true, arcs carry
+// their edge's weight, and an error is returned if any edge is not weighted or, if
+// nonNegative is true, has a negative weight; otherwise, every arc has a weight of 1.
+func index(g gogl.Graph, weighted, nonNegative bool) (*indexed, error) {
+	ix := &indexed{index: make(map[gogl.Vertex]int)}
+	g.Vertices(func(v gogl.Vertex) (terminate bool) {
+		ix.index[v] = len(ix.vertices)
+		ix.vertices = append(ix.vertices, v)
+		return
+	})
+
+	n := len(ix.vertices)
+	ix.out = make([][]indexedArc, n)
+
+	var err error
+	add := func(e gogl.Edge, u, v gogl.Vertex) (terminate bool) {
+		w := 1.0
+		if weighted {
+			we, ok := e.(gogl.WeightedEdge)
+			if !ok {
+				err = errors.New("Graph contains an edge that is not weighted.")
+				return true
+			}
+			if w = we.Weight(); w < 0 && nonNegative {
+				err = fmt.Errorf("Negative edge weight encountered between %v and %v; weights must be non-negative.", u, v)
+				return true
+			}
+		}
+
+		i, j := ix.index[u], ix.index[v]
+		ix.out[i] = append(ix.out[i], indexedArc{to: j, w: w})
+		if !ix.directed && i != j {
+			ix.out[j] = append(ix.out[j], indexedArc{to: i, w: w})
+		}
+		return
+	}
+
+	if dg, ok := g.(gogl.Digraph); ok {
+		ix.directed = true
+		dg.Arcs(func(a gogl.Arc) (terminate bool) {
+			return add(a, a.Source(), a.Target())
+		})
+	} else {
+		g.Edges(func(e gogl.Edge) (terminate bool) {
+			u, v := e.Both()
+			return add(e, u, v)
+		})
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if !ix.directed {
+		ix.in = ix.out
+		return ix, nil
+	}
+
+	ix.in = make([][]indexedArc, n)
+	for i, arcs := range ix.out {
+		for _, a := range arcs {
+			ix.in[a.to] = append(ix.in[a.to], indexedArc{to: i, w: a.w})
+		}
+	}
+
+	return ix, nil
+}
+
+// Converts a dense score slice back into a vertex-keyed map.
+func (ix *indexed) scores(values []float64) map[gogl.Vertex]float64 {
+	scores := make(map[gogl.Vertex]float64, len(values))
+	for i, v := range ix.vertices {
+		scores[v] = values[i]
+	}
+
+	return scores
+}
@@ -0,0 +1,93 @@
+package centrality
+
+import (
+	"math"
+	"testing"
+
+	. "github.com/sdboyer/gocheck"
+	"github.com/sdboyer/gogl"
+	"github.com/sdboyer/gogl/graph/al"
+)
+
+// Hook gocheck into the go test runner
+func Test(t *testing.T) { TestingT(t) }
+
+// A star, with "hub" at the center of four spokes.
+var starEdgeSet = gogl.EdgeList{
+	gogl.NewEdge("hub", "a"),
+	gogl.NewEdge("hub", "b"),
+	gogl.NewEdge("hub", "c"),
+	gogl.NewEdge("hub", "d"),
+}
+
+// A five-vertex path, a-b-c-d-e.
+var pathEdgeSet = gogl.EdgeList{
+	gogl.NewEdge("a", "b"),
+	gogl.NewEdge("b", "c"),
+	gogl.NewEdge("c", "d"),
+	gogl.NewEdge("d", "e"),
+}
+
+// Asserts that the scores match the expected values to within a small tolerance.
+func checkScores(c *C, scores, expected map[gogl.Vertex]float64) {
+	c.Assert(len(scores), Equals, len(expected))
+	for v, want := range expected {
+		got, exists := scores[v]
+		c.Assert(exists, Equals, true, Commentf("vertex %v", v))
+		c.Assert(math.Abs(got-want) < 1e-6, Equals, true, Commentf("vertex %v: got %v, want %v", v, got, want))
+	}
+}
+
+// A weighted graph wrapper that yields unweighted edges.
+type unweighted struct {
+	gogl.WeightedGraph
+}
+
+func (g unweighted) Edges(f gogl.EdgeStep) {
+	g.WeightedGraph.Edges(func(e gogl.Edge) (terminate bool) {
+		return f(gogl.NewEdge(e.Both()))
+	})
+}
+
+type DegreeSuite struct{}
+
+var _ = Suite(&DegreeSuite{})
+
+func (s *DegreeSuite) TestDegree(c *C) {
+	g := gogl.Spec().Mutable().Using(starEdgeSet).Create(al.G).(gogl.MutableGraph)
+	checkScores(c, Degree(g), map[gogl.Vertex]float64{
+		"hub": 1, "a": 0.25, "b": 0.25, "c": 0.25, "d": 0.25,
+	})
+
+	g.EnsureVertex("isolate")
+	c.Assert(Degree(g)["isolate"], Equals, float64(0))
+	c.Assert(Degree(g)["hub"], Equals, 0.8)
+
+	c.Assert(Degree(gogl.Spec().Create(al.G)), HasLen, 0)
+}
+
+func (s *DegreeSuite) TestDirectedDegree(c *C) {
+	g := gogl.Spec().Directed().Using(gogl.ArcList{
+		gogl.NewArc("a", "b"),
+		gogl.NewArc("a", "c"),
+		gogl.NewArc("b", "c"),
+	}).Create(al.G).(gogl.Digraph)
+
+	checkScores(c, InDegree(g), map[gogl.Vertex]float64{"a": 0, "b": 0.5, "c": 1})
+	checkScores(c, OutDegree(g), map[gogl.Vertex]float64{"a": 1, "b": 0.5, "c": 0})
+	checkScores(c, Degree(g), map[gogl.Vertex]float64{"a": 1, "b": 1, "c": 1})
+}
+
+func (s *DegreeSuite) TestWeightedDegree(c *C) {
+	g := gogl.Spec().Weighted().Using(gogl.WeightedEdgeList{
+		gogl.NewWeightedEdge("a", "b", 2),
+		gogl.NewWeightedEdge("b", "c", 3.5),
+	}).Create(al.G).(gogl.WeightedGraph)
+
+	scores, err := WeightedDegree(g)
+	c.Assert(err, IsNil)
+	checkScores(c, scores, map[gogl.Vertex]float64{"a": 2, "b": 5.5, "c": 3.5})
+
+	_, err = WeightedDegree(unweighted{g})
+	c.Assert(err, ErrorMatches, "Graph contains an edge that is not weighted.")
+}
@@ -0,0 +1,51 @@
+package centrality
+
+import (
+	"github.com/sdboyer/gogl"
+)
+
+// Calculates the closeness centrality of every vertex in the provided graph: the
+// reciprocal of the average distance from the vertex to every other vertex it can
+// reach. For digraphs, distances are measured along outgoing arcs.
+//
+// So that vertices in small components do not score highly merely by being close to
+// their few neighbors, each score is also scaled by the fraction of the graph that the
+// vertex can reach (the Wasserman-Faust correction). A vertex that cannot reach any
+// other vertex scores 0.
+func Closeness(g gogl.Graph) map[gogl.Vertex]float64 {
+	ix, _ := index(g, false, false)
+	return ix.scores(ix.closeness(true))
+}
+
+// Calculates closeness centrality as per Closeness, but with distances measured by
+// the sum of edge weights along the shortest path. Weights must be non-negative; an
+// error is returned if a negative weight is encountered.
+func WeightedCloseness(g gogl.WeightedGraph) (map[gogl.Vertex]float64, error) {
+	ix, err := index(g, true, true)
+	if err != nil {
+		return nil, err
+	}
+
+	return ix.scores(ix.closeness(false)), nil
+}
+
+func (ix *indexed) closeness(unweighted bool) []float64 {
+	n := len(ix.vertices)
+	cc := make([]float64, n)
+	r := newSPResult(n)
+
+	for s := 0; s < n; s++ {
+		ix.shortestPaths(s, unweighted, r)
+
+		var total float64
+		for _, v := range r.order {
+			total += r.dist[v]
+		}
+
+		if reached := float64(len(r.order) - 1); reached > 0 && total > 0 {
+			cc[s] = reached / total * reached / float64(n-1)
+		}
+	}
+
+	return cc
+}
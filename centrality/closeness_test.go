@@ -0,0 +1,52 @@
+package centrality
+
+import (
+	. "github.com/sdboyer/gocheck"
+	"github.com/sdboyer/gogl"
+	"github.com/sdboyer/gogl/graph/al"
+)
+
+type ClosenessSuite struct{}
+
+var _ = Suite(&ClosenessSuite{})
+
+func (s *ClosenessSuite) TestCloseness(c *C) {
+	g := gogl.Spec().Using(pathEdgeSet).Create(al.G)
+	checkScores(c, Closeness(g), map[gogl.Vertex]float64{
+		"a": 0.4, "b": 4.0 / 7, "c": 4.0 / 6, "d": 4.0 / 7, "e": 0.4,
+	})
+
+	// a second component shrinks scores by the fraction of the graph reachable
+	g = gogl.Spec().Using(append(gogl.EdgeList{gogl.NewEdge("x", "y")}, starEdgeSet...)).Create(al.G)
+	checkScores(c, Closeness(g), map[gogl.Vertex]float64{
+		"hub": 4.0 / 6, "a": 4.0 / 7 * 4 / 6, "b": 4.0 / 7 * 4 / 6, "c": 4.0 / 7 * 4 / 6, "d": 4.0 / 7 * 4 / 6,
+		"x": 1.0 / 6, "y": 1.0 / 6,
+	})
+}
+
+func (s *ClosenessSuite) TestClosenessDirected(c *C) {
+	g := gogl.Spec().Directed().Using(gogl.ArcList{
+		gogl.NewArc("a", "b"),
+		gogl.NewArc("b", "c"),
+	}).Create(al.G)
+
+	checkScores(c, Closeness(g), map[gogl.Vertex]float64{
+		"a": 2.0 / 3, "b": 0.5, "c": 0,
+	})
+}
+
+func (s *ClosenessSuite) TestWeightedCloseness(c *C) {
+	g := gogl.Spec().Weighted().Using(gogl.WeightedEdgeList{
+		gogl.NewWeightedEdge("a", "b", 2),
+		gogl.NewWeightedEdge("b", "c", 3),
+	}).Create(al.G).(gogl.WeightedGraph)
+
+	scores, err := WeightedCloseness(g)
+	c.Assert(err, IsNil)
+	checkScores(c, scores, map[gogl.Vertex]float64{
+		"a": 2.0 / 7, "b": 2.0 / 5, "c": 2.0 / 8,
+	})
+
+	_, err = WeightedCloseness(unweighted{g})
+	c.Assert(err, ErrorMatches, "Graph contains an edge that is not weighted.")
+}
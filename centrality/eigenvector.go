@@ -0,0 +1,88 @@
+package centrality
+
+import (
+	"math"
+
+	"github.com/sdboyer/gogl"
+)
+
+// Calculates the eigenvector centrality of every vertex in the provided graph: a
+// vertex is important if it is adjacent to other important vertices. For digraphs,
+// a vertex's importance derives from the vertices with arcs to it.
+//
+// Scores are found by power iteration, and are scaled to have a Euclidean norm of 1.
+// Iteration stops once an iteration changes the scores by a total of less than the
+// given tolerance, or after maxIterations iterations; the returned Convergence reports
+// which occurred.
+//
+// Eigenvector centrality is only well-defined for strongly connected graphs. For other
+// graphs, scores tend to concentrate in the components that can reach no others.
+func Eigenvector(g gogl.Graph, tolerance float64, maxIterations int) (map[gogl.Vertex]float64, Convergence) {
+	ix, _ := index(g, false, false)
+	scores, conv := ix.eigenvector(tolerance, maxIterations)
+	return ix.scores(scores), conv
+}
+
+// Calculates eigenvector centrality as per Eigenvector, with each adjacency counting
+// in proportion to its edge's weight. Weights must be non-negative; an error is
+// returned if a negative weight is encountered.
+func WeightedEigenvector(g gogl.WeightedGraph, tolerance float64, maxIterations int) (map[gogl.Vertex]float64, Convergence, error) {
+	ix, err := index(g, true, true)
+	if err != nil {
+		return nil, Convergence{}, err
+	}
+
+	scores, conv := ix.eigenvector(tolerance, maxIterations)
+	return ix.scores(scores), conv, nil
+}
+
+func (ix *indexed) eigenvector(tolerance float64, maxIterations int) ([]float64, Convergence) {
+	n := len(ix.vertices)
+	x := make([]float64, n)
+	next := make([]float64, n)
+	for i := range x {
+		x[i] = 1 / float64(n)
+	}
+
+	var conv Convergence
+	if n == 0 {
+		conv.Converged = true
+		return x, conv
+	}
+
+	for conv.Iterations < maxIterations {
+		conv.Iterations++
+
+		// Iterating with A + I, rather than A alone, has the same dominant eigenvector,
+		// but does not oscillate on bipartite graphs.
+		copy(next, x)
+		for v, arcs := range ix.in {
+			for _, a := range arcs {
+				next[v] += x[a.to] * a.w
+			}
+		}
+
+		var norm float64
+		for _, s := range next {
+			norm += s * s
+		}
+		norm = math.Sqrt(norm)
+		if norm == 0 {
+			norm = 1
+		}
+
+		conv.Residual = 0
+		for i := range next {
+			next[i] /= norm
+			conv.Residual += math.Abs(next[i] - x[i])
+		}
+		x, next = next, x
+
+		if conv.Residual < tolerance {
+			conv.Converged = true
+			break
+		}
+	}
+
+	return x, conv
+}
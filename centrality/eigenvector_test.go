@@ -0,0 +1,71 @@
+package centrality
+
+import (
+	"math"
+
+	. "github.com/sdboyer/gocheck"
+	"github.com/sdboyer/gogl"
+	"github.com/sdboyer/gogl/graph/al"
+)
+
+type EigenvectorSuite struct{}
+
+var _ = Suite(&EigenvectorSuite{})
+
+func (s *EigenvectorSuite) TestEigenvector(c *C) {
+	// The star is bipartite; the dominant eigenvector gives the hub sqrt(4) times the
+	// score of each spoke.
+	g := gogl.Spec().Using(starEdgeSet).Create(al.G)
+	scores, conv := Eigenvector(g, 1e-12, 1000)
+	c.Assert(conv.Converged, Equals, true)
+	c.Assert(conv.Residual < 1e-12, Equals, true)
+
+	spoke := 1 / math.Sqrt(8)
+	checkScores(c, scores, map[gogl.Vertex]float64{
+		"hub": 2 * spoke, "a": spoke, "b": spoke, "c": spoke, "d": spoke,
+	})
+
+	// not enough iterations
+	_, conv = Eigenvector(g, 1e-12, 2)
+	c.Assert(conv.Converged, Equals, false)
+	c.Assert(conv.Iterations, Equals, 2)
+
+	scores, conv = Eigenvector(gogl.Spec().Create(al.G), 1e-12, 10)
+	c.Assert(scores, HasLen, 0)
+	c.Assert(conv.Converged, Equals, true)
+}
+
+func (s *EigenvectorSuite) TestEigenvectorDirected(c *C) {
+	// A directed cycle with a chord into c; c receives the most.
+	g := gogl.Spec().Directed().Using(gogl.ArcList{
+		gogl.NewArc("a", "b"),
+		gogl.NewArc("b", "c"),
+		gogl.NewArc("c", "a"),
+		gogl.NewArc("a", "c"),
+	}).Create(al.G)
+
+	scores, conv := Eigenvector(g, 1e-12, 1000)
+	c.Assert(conv.Converged, Equals, true)
+	c.Assert(scores["c"] > scores["a"], Equals, true)
+	c.Assert(scores["a"] > scores["b"], Equals, true)
+}
+
+func (s *EigenvectorSuite) TestWeightedEigenvector(c *C) {
+	// Doubling one spoke's weight makes it the more important of the two.
+	g := gogl.Spec().Weighted().Using(gogl.WeightedEdgeList{
+		gogl.NewWeightedEdge("hub", "a", 2),
+		gogl.NewWeightedEdge("hub", "b", 1),
+	}).Create(al.G).(gogl.WeightedGraph)
+
+	scores, conv, err := WeightedEigenvector(g, 1e-12, 1000)
+	c.Assert(err, IsNil)
+	c.Assert(conv.Converged, Equals, true)
+
+	// eigenvalue sqrt(5): hub = sqrt(5)/sqrt(10), a = 2/sqrt(10), b = 1/sqrt(10)
+	checkScores(c, scores, map[gogl.Vertex]float64{
+		"hub": math.Sqrt(0.5), "a": 2 / math.Sqrt(10), "b": 1 / math.Sqrt(10),
+	})
+
+	_, _, err = WeightedEigenvector(unweighted{g}, 1e-12, 1000)
+	c.Assert(err, ErrorMatches, "Graph contains an edge that is not weighted.")
+}
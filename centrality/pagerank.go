@@ -0,0 +1,106 @@
+package centrality
+
+import (
+	"errors"
+	"math"
+
+	"github.com/sdboyer/gogl"
+)
+
+// Calculates the PageRank of every vertex in the provided graph: the long-run
+// probability that a random walk finds itself at the vertex.
+//
+// At each step, the walk follows a random outgoing arc (or, in an undirected graph, a
+// random incident edge) with the given damping probability, and otherwise jumps to
+// a vertex chosen uniformly at random. A walk at a vertex with no outgoing arcs always
+// jumps. The damping factor must be between 0 and 1; 0.85 is conventional. Scores
+// sum to 1.
+//
+// Scores are found by power iteration. Iteration stops once an iteration changes the
+// scores by a total of less than the given tolerance, or after maxIterations iterations;
+// the returned Convergence reports which occurred.
+func PageRank(g gogl.Graph, damping, tolerance float64, maxIterations int) (map[gogl.Vertex]float64, Convergence, error) {
+	if damping < 0 || damping > 1 {
+		return nil, Convergence{}, errors.New("Damping factor must be between 0 and 1.")
+	}
+
+	ix, _ := index(g, false, false)
+	scores, conv := ix.pagerank(damping, tolerance, maxIterations)
+	return ix.scores(scores), conv, nil
+}
+
+// Calculates PageRank as per PageRank, but with the walk choosing among outgoing arcs
+// in proportion to their weights. Weights must be non-negative; an error is returned if
+// a negative weight is encountered. A vertex whose outgoing arcs all have a weight of 0
+// is treated as having none.
+func WeightedPageRank(g gogl.WeightedGraph, damping, tolerance float64, maxIterations int) (map[gogl.Vertex]float64, Convergence, error) {
+	if damping < 0 || damping > 1 {
+		return nil, Convergence{}, errors.New("Damping factor must be between 0 and 1.")
+	}
+
+	ix, err := index(g, true, true)
+	if err != nil {
+		return nil, Convergence{}, err
+	}
+
+	scores, conv := ix.pagerank(damping, tolerance, maxIterations)
+	return ix.scores(scores), conv, nil
+}
+
+func (ix *indexed) pagerank(damping, tolerance float64, maxIterations int) ([]float64, Convergence) {
+	n := len(ix.vertices)
+	x := make([]float64, n)
+	next := make([]float64, n)
+	outWeight := make([]float64, n)
+	for i := range x {
+		x[i] = 1 / float64(n)
+		for _, a := range ix.out[i] {
+			outWeight[i] += a.w
+		}
+	}
+
+	var conv Convergence
+	if n == 0 {
+		conv.Converged = true
+		return x, conv
+	}
+
+	for conv.Iterations < maxIterations {
+		conv.Iterations++
+
+		// Mass at vertices with nowhere to go is spread evenly, as is the teleport mass.
+		var dangling float64
+		for i, w := range outWeight {
+			if w == 0 {
+				dangling += x[i]
+			}
+		}
+		base := (1-damping)/float64(n) + damping*dangling/float64(n)
+
+		for i := range next {
+			next[i] = base
+		}
+		for u, arcs := range ix.out {
+			if outWeight[u] == 0 {
+				continue
+			}
+			share := damping * x[u] / outWeight[u]
+			for _, a := range arcs {
+				next[a.to] += share * a.w
+			}
+		}
+
+		conv.Residual = 0
+		for i := range next {
+			conv.Residual += math.Abs(next[i] - x[i])
+		}
+		x, next = next, x
+
+		if conv.Residual < tolerance {
+			conv.Converged = true
+			break
+		}
+	}
+
+	return x, conv
+}
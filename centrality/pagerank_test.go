@@ -0,0 +1,81 @@
+package centrality
+
+import (
+	"math"
+
+	. "github.com/sdboyer/gocheck"
+	"github.com/sdboyer/gogl"
+	"github.com/sdboyer/gogl/graph/al"
+)
+
+type PageRankSuite struct{}
+
+var _ = Suite(&PageRankSuite{})
+
+func (s *PageRankSuite) TestPageRank(c *C) {
+	// a links to b, which links nowhere. Solving the stationary equations directly:
+	// a = 0.075 + 0.425b, b = 0.075 + 0.425b + 0.85a, a + b = 1
+	g := gogl.Spec().Directed().Using(gogl.ArcList{gogl.NewArc("a", "b")}).Create(al.G)
+
+	scores, conv, err := PageRank(g, 0.85, 1e-12, 1000)
+	c.Assert(err, IsNil)
+	c.Assert(conv.Converged, Equals, true)
+	checkScores(c, scores, map[gogl.Vertex]float64{"a": 0.5 / 1.425, "b": 1 - 0.5/1.425})
+
+	// symmetry
+	g = gogl.Spec().Directed().Using(gogl.ArcList{
+		gogl.NewArc("a", "b"),
+		gogl.NewArc("b", "c"),
+		gogl.NewArc("c", "a"),
+	}).Create(al.G)
+	scores, _, _ = PageRank(g, 0.85, 1e-12, 1000)
+	checkScores(c, scores, map[gogl.Vertex]float64{"a": 1.0 / 3, "b": 1.0 / 3, "c": 1.0 / 3})
+
+	_, _, err = PageRank(g, 1.5, 1e-12, 1000)
+	c.Assert(err, ErrorMatches, "Damping factor must be between 0 and 1.")
+}
+
+func (s *PageRankSuite) TestPageRankStationary(c *C) {
+	// In an undirected star, the scores must satisfy the stationary equations and sum to 1.
+	g := gogl.Spec().Using(starEdgeSet).Create(al.G)
+	scores, conv, err := PageRank(g, 0.85, 1e-12, 1000)
+	c.Assert(err, IsNil)
+	c.Assert(conv.Converged, Equals, true)
+
+	var total float64
+	for _, score := range scores {
+		total += score
+	}
+	c.Assert(math.Abs(total-1) < 1e-9, Equals, true)
+
+	// hub = 0.03 + 0.85 * 4 * spoke; spoke = 0.03 + 0.85 * hub / 4
+	hub, spoke := scores["hub"], scores["a"]
+	c.Assert(math.Abs(hub-(0.03+0.85*4*spoke)) < 1e-9, Equals, true)
+	c.Assert(math.Abs(spoke-(0.03+0.85*hub/4)) < 1e-9, Equals, true)
+
+	_, conv, _ = PageRank(g, 0.85, 1e-12, 3)
+	c.Assert(conv.Converged, Equals, false)
+	c.Assert(conv.Iterations, Equals, 3)
+}
+
+func (s *PageRankSuite) TestWeightedPageRank(c *C) {
+	// From a, the walk goes to b three times as often as to c.
+	g := gogl.Spec().Directed().Weighted().Using(gogl.WeightedArcList{
+		gogl.NewWeightedArc("a", "b", 3),
+		gogl.NewWeightedArc("a", "c", 1),
+		gogl.NewWeightedArc("b", "a", 1),
+		gogl.NewWeightedArc("c", "a", 1),
+	}).Create(al.G).(gogl.WeightedGraph)
+
+	scores, conv, err := WeightedPageRank(g, 0.85, 1e-12, 1000)
+	c.Assert(err, IsNil)
+	c.Assert(conv.Converged, Equals, true)
+	c.Assert(scores["b"] > scores["c"], Equals, true)
+
+	// b = 0.05 + 0.85 * 0.75 * a; c = 0.05 + 0.85 * 0.25 * a
+	c.Assert(math.Abs(scores["b"]-(0.05+0.85*0.75*scores["a"])) < 1e-9, Equals, true)
+	c.Assert(math.Abs(scores["c"]-(0.05+0.85*0.25*scores["a"])) < 1e-9, Equals, true)
+
+	_, _, err = WeightedPageRank(unweighted{g}, 0.85, 1e-12, 1000)
+	c.Assert(err, NotNil)
+}
@@ -0,0 +1,114 @@
+package centrality
+
+import (
+	"container/heap"
+)
+
+// The results of a single-source shortest path search over an indexed graph, as
+// required by Brandes' algorithm.
+type spResult struct {
+	// The distance to each vertex from the source; -1 if unreachable.
+	dist []float64
+	// The number of distinct shortest paths from the source to each vertex.
+	sigma []float64
+	// The predecessors of each vertex across all its shortest paths.
+	preds [][]int
+	// Reached vertices, in non-decreasing order of distance.
+	order []int
+}
+
+func newSPResult(n int) *spResult {
+	return &spResult{
+		dist:  make([]float64, n),
+		sigma: make([]float64, n),
+		preds: make([][]int, n),
+	}
+}
+
+// Searches from the source vertex, by breadth-first search if unweighted is true,
+// otherwise by Dijkstra's algorithm. The provided result is reset and reused.
+func (ix *indexed) shortestPaths(s int, unweighted bool, r *spResult) {
+	for i := range r.dist {
+		r.dist[i] = -1
+		r.sigma[i] = 0
+		r.preds[i] = r.preds[i][:0]
+	}
+	r.order = r.order[:0]
+	r.dist[s] = 0
+	r.sigma[s] = 1
+
+	if unweighted {
+		queue := []int{s}
+		for len(queue) > 0 {
+			u := queue[0]
+			queue = queue[1:]
+			r.order = append(r.order, u)
+
+			for _, a := range ix.out[u] {
+				if r.dist[a.to] < 0 {
+					r.dist[a.to] = r.dist[u] + 1
+					queue = append(queue, a.to)
+				}
+				if r.dist[a.to] == r.dist[u]+1 {
+					r.sigma[a.to] += r.sigma[u]
+					r.preds[a.to] = append(r.preds[a.to], u)
+				}
+			}
+		}
+		return
+	}
+
+	done := make([]bool, len(r.dist))
+	h := &iheap{}
+	heap.Push(h, iitem{s, 0})
+
+	for h.Len() > 0 {
+		item := heap.Pop(h).(iitem)
+		u := item.v
+		if done[u] {
+			continue
+		}
+		done[u] = true
+		r.order = append(r.order, u)
+
+		for _, a := range ix.out[u] {
+			alt := r.dist[u] + a.w
+			switch {
+			case r.dist[a.to] < 0 || alt < r.dist[a.to]:
+				r.dist[a.to] = alt
+				r.sigma[a.to] = r.sigma[u]
+				r.preds[a.to] = append(r.preds[a.to][:0], u)
+				heap.Push(h, iitem{a.to, alt})
+			case alt == r.dist[a.to] && !done[a.to]:
+				r.sigma[a.to] += r.sigma[u]
+				r.preds[a.to] = append(r.preds[a.to], u)
+			}
+		}
+	}
+}
+
+type iitem struct {
+	v        int
+	priority float64
+}
+
+// A min-heap of vertex indices. Implements heap.Interface.
+type iheap []iitem
+
+func (h iheap) Len() int { return len(h) }
+
+func (h iheap) Less(i, j int) bool { return h[i].priority < h[j].priority }
+
+func (h iheap) Swap(i, j int) { h[i], h[j] = h[j], h[i] }
+
+func (h *iheap) Push(x interface{}) {
+	*h = append(*h, x.(iitem))
+}
+
+func (h *iheap) Pop() interface{} {
+	old := *h
+	n := len(old)
+	item := old[n-1]
+	*h = old[:n-1]
+	return item
+}
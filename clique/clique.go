@@ -0,0 +1,203 @@
+// Contains algos and logic related to cliques and independent sets.
+//
+// A clique is a set of vertices that are all adjacent to one another; an independent
+// set is a set of vertices no two of which are adjacent. All algorithms here treat the
+// graph as undirected; for a digraph, arc directionality is ignored. Loops are ignored.
+package clique
+
+import (
+	"github.com/sdboyer/gogl"
+)
+
+// A CliqueStep is called with each clique found by an enumeration, in the style of
+// VertexStep. Returning true terminates the enumeration.
+//
+// Each clique is a new slice, which the step function may retain.
+type CliqueStep func(clique []gogl.Vertex) (terminate bool)
+
+// Enumerates the maximal cliques of the provided graph - those that cannot be extended
+// by adding another vertex - using the Bron-Kerbosch algorithm with pivoting, passing
+// each to the provided step function.
+//
+// Every vertex appears in at least one maximal clique; a vertex isolate forms a clique
+// of its own. Neither the cliques nor their members are in any particular order.
+//
+// Pivoting keeps the search from exploring cliques it can prove are not maximal, and
+// bounds its running time at O(3^(V/3)), the largest number of maximal cliques any graph
+// on V vertices can have.
+func MaximalCliques(g gogl.Graph, f CliqueStep) {
+	ix := index(g)
+	ix.maximalCliques(f)
+}
+
+// Finds a maximum clique in the provided graph: a clique with as many vertices as any
+// other. If there are several, which is returned is arbitrary. If the graph has no
+// vertices, nil is returned.
+//
+// Finding a maximum clique is NP-hard; this enumerates every maximal clique, and so is
+// practical only where MaximalCliques is.
+func MaximumClique(g gogl.Graph) []gogl.Vertex {
+	return index(g).maximum()
+}
+
+// A set of small non-negative integers.
+type bitset []uint64
+
+func newBitset(n int) bitset {
+	return make(bitset, (n+63)/64)
+}
+
+func (b bitset) set(i int) {
+	b[i/64] |= 1 << uint(i%64)
+}
+
+func (b bitset) has(i int) bool {
+	return b[i/64]&(1<<uint(i%64)) != 0
+}
+
+// A dense, index-based snapshot of a graph's adjacencies.
+type indexed struct {
+	vertices []gogl.Vertex
+	adj      []bitset
+}
+
+func index(g gogl.Graph) *indexed {
+	ix := &indexed{}
+	idx := make(map[gogl.Vertex]int)
+	g.Vertices(func(v gogl.Vertex) (terminate bool) {
+		idx[v] = len(ix.vertices)
+		ix.vertices = append(ix.vertices, v)
+		return
+	})
+
+	ix.adj = make([]bitset, len(ix.vertices))
+	for i := range ix.adj {
+		ix.adj[i] = newBitset(len(ix.vertices))
+	}
+
+	g.Edges(func(e gogl.Edge) (terminate bool) {
+		u, v := e.Both()
+		i, j := idx[u], idx[v]
+		if i != j {
+			ix.adj[i].set(j)
+			ix.adj[j].set(i)
+		}
+		return
+	})
+
+	return ix
+}
+
+// Builds the complement of the indexed graph, in which vertices are adjacent iff they
+// are not adjacent in the original.
+func (ix *indexed) complement() *indexed {
+	c := &indexed{vertices: ix.vertices, adj: make([]bitset, len(ix.adj))}
+	for i := range c.adj {
+		c.adj[i] = newBitset(len(ix.vertices))
+		for j := range ix.vertices {
+			if i != j && !ix.adj[i].has(j) {
+				c.adj[i].set(j)
+			}
+		}
+	}
+
+	return c
+}
+
+func (ix *indexed) maximalCliques(f CliqueStep) {
+	// Without this, the empty graph would yield one empty clique.
+	if len(ix.vertices) == 0 {
+		return
+	}
+
+	p := make([]int, len(ix.vertices))
+	for i := range p {
+		p[i] = i
+	}
+
+	ix.expand(nil, p, nil, func(r []int) bool {
+		clique := make([]gogl.Vertex, len(r))
+		for i, v := range r {
+			clique[i] = ix.vertices[v]
+		}
+		return f(clique)
+	})
+}
+
+func (ix *indexed) maximum() []gogl.Vertex {
+	var max []gogl.Vertex
+	ix.maximalCliques(func(clique []gogl.Vertex) (terminate bool) {
+		if len(clique) > len(max) {
+			max = clique
+		}
+		return
+	})
+
+	return max
+}
+
+// The Bron-Kerbosch recursion. r is the clique under construction, p the candidates
+// that could extend it, and x those that could extend it but have already been
+// explored. Returns true if the enumeration was terminated.
+func (ix *indexed) expand(r, p, x []int, f func([]int) bool) bool {
+	if len(p) == 0 {
+		if len(x) == 0 {
+			return f(r)
+		}
+		return false
+	}
+
+	// Any maximal clique must contain the pivot or one of its non-neighbors, so only
+	// those need to be tried. Choosing the pivot with the most neighbors among the
+	// candidates leaves the fewest branches.
+	pivot, best := -1, -1
+	for _, set := range [2][]int{p, x} {
+		for _, u := range set {
+			n := 0
+			for _, v := range p {
+				if ix.adj[u].has(v) {
+					n++
+				}
+			}
+			if n > best {
+				pivot, best = u, n
+			}
+		}
+	}
+
+	var branches []int
+	for _, v := range p {
+		if !ix.adj[pivot].has(v) {
+			branches = append(branches, v)
+		}
+	}
+
+	for _, v := range branches {
+		var np, nx []int
+		for _, w := range p {
+			if ix.adj[v].has(w) {
+				np = append(np, w)
+			}
+		}
+		for _, w := range x {
+			if ix.adj[v].has(w) {
+				nx = append(nx, w)
+			}
+		}
+
+		if ix.expand(append(r, v), np, nx, f) {
+			return true
+		}
+
+		// Move v from the candidates to the explored set.
+		for i, w := range p {
+			if w == v {
+				p = append(p[:i:i], p[i+1:]...)
+				break
+			}
+		}
+		x = append(x[:len(x):len(x)], v)
+	}
+
+	return false
+}
@@ -0,0 +1,161 @@
+package clique
+
+import (
+	"fmt"
+	stdrand "math/rand"
+	"sort"
+	"testing"
+
+	. "github.com/sdboyer/gocheck"
+	"github.com/sdboyer/gogl"
+	"github.com/sdboyer/gogl/graph/al"
+)
+
+// Hook gocheck into the go test runner
+func Test(t *testing.T) { TestingT(t) }
+
+// A 4-clique (a-d) sharing vertex d with a triangle (d-f), plus a pendant edge f-g.
+var cliqueEdgeSet = gogl.EdgeList{
+	gogl.NewEdge("a", "b"),
+	gogl.NewEdge("a", "c"),
+	gogl.NewEdge("a", "d"),
+	gogl.NewEdge("b", "c"),
+	gogl.NewEdge("b", "d"),
+	gogl.NewEdge("c", "d"),
+	gogl.NewEdge("d", "e"),
+	gogl.NewEdge("d", "f"),
+	gogl.NewEdge("e", "f"),
+	gogl.NewEdge("f", "g"),
+}
+
+// Renders a vertex set in sorted order, for comparison.
+func setString(vertices []gogl.Vertex) string {
+	var s []string
+	for _, v := range vertices {
+		s = append(s, fmt.Sprint(v))
+	}
+	sort.Strings(s)
+	return fmt.Sprint(s)
+}
+
+func collect(g gogl.Graph, enumerate func(gogl.Graph, CliqueStep)) []string {
+	var out []string
+	enumerate(g, func(clique []gogl.Vertex) (terminate bool) {
+		out = append(out, setString(clique))
+		return
+	})
+	sort.Strings(out)
+	return out
+}
+
+// Generates a random undirected graph on n int vertices, with each possible edge present
+// with probability p; also returns its adjacency matrix.
+func randomGraph(r *stdrand.Rand, n int, p float64) (gogl.Graph, [][]bool) {
+	g := gogl.Spec().Mutable().Create(al.G).(gogl.MutableGraph)
+	adj := make([][]bool, n)
+	for i := range adj {
+		adj[i] = make([]bool, n)
+	}
+	for i := 0; i < n; i++ {
+		g.EnsureVertex(i)
+		for j := 0; j < i; j++ {
+			if r.Float64() < p {
+				g.AddEdges(gogl.NewEdge(i, j))
+				adj[i][j], adj[j][i] = true, true
+			}
+		}
+	}
+	return g.(gogl.Graph), adj
+}
+
+// Finds all maximal sets of vertices in which every pair satisfies the relation, and the
+// size of the largest, by checking every subset.
+func bruteMaximal(n int, related func(i, j int) bool) (sets []string, largest int) {
+	valid := func(mask int) bool {
+		for i := 0; i < n; i++ {
+			for j := 0; j < i; j++ {
+				if mask&(1<<uint(i)) != 0 && mask&(1<<uint(j)) != 0 && !related(i, j) {
+					return false
+				}
+			}
+		}
+		return true
+	}
+
+	for mask := 1; mask < 1<<uint(n); mask++ {
+		if !valid(mask) {
+			continue
+		}
+		maximal := true
+		for i := 0; i < n && maximal; i++ {
+			if mask&(1<<uint(i)) == 0 && valid(mask|1<<uint(i)) {
+				maximal = false
+			}
+		}
+		if maximal {
+			var set []gogl.Vertex
+			for i := 0; i < n; i++ {
+				if mask&(1<<uint(i)) != 0 {
+					set = append(set, i)
+				}
+			}
+			sets = append(sets, setString(set))
+			if len(set) > largest {
+				largest = len(set)
+			}
+		}
+	}
+	sort.Strings(sets)
+	return sets, largest
+}
+
+type CliqueSuite struct{}
+
+var _ = Suite(&CliqueSuite{})
+
+func (s *CliqueSuite) TestMaximalCliques(c *C) {
+	g := gogl.Spec().Mutable().Using(cliqueEdgeSet).Create(al.G).(gogl.MutableGraph)
+	g.EnsureVertex("isolate")
+
+	c.Assert(collect(g.(gogl.Graph), MaximalCliques), DeepEquals, []string{
+		"[a b c d]",
+		"[d e f]",
+		"[f g]",
+		"[isolate]",
+	})
+
+	// enumeration stops when the step function says so
+	var count int
+	MaximalCliques(g.(gogl.Graph), func(clique []gogl.Vertex) (terminate bool) {
+		count++
+		return true
+	})
+	c.Assert(count, Equals, 1)
+
+	c.Assert(collect(gogl.Spec().Create(al.G), MaximalCliques), HasLen, 0)
+}
+
+func (s *CliqueSuite) TestMaximalCliquesRandom(c *C) {
+	r := stdrand.New(stdrand.NewSource(13))
+	for iter := 0; iter < 20; iter++ {
+		g, adj := randomGraph(r, 10, 0.5)
+		expected, _ := bruteMaximal(10, func(i, j int) bool { return adj[i][j] })
+		c.Assert(collect(g, MaximalCliques), DeepEquals, expected, Commentf("iteration %d", iter))
+	}
+}
+
+func (s *CliqueSuite) TestMaximumClique(c *C) {
+	g := gogl.Spec().Using(cliqueEdgeSet).Create(al.G)
+	c.Assert(setString(MaximumClique(g)), Equals, "[a b c d]")
+
+	c.Assert(MaximumClique(gogl.Spec().Create(al.G)), IsNil)
+
+	// directionality is ignored
+	dg := gogl.Spec().Directed().Using(gogl.ArcList{
+		gogl.NewArc("a", "b"),
+		gogl.NewArc("b", "c"),
+		gogl.NewArc("c", "a"),
+		gogl.NewArc("c", "d"),
+	}).Create(al.G)
+	c.Assert(setString(MaximumClique(dg)), Equals, "[a b c]")
+}
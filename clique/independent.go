@@ -0,0 +1,23 @@
+package clique
+
+import (
+	"github.com/sdboyer/gogl"
+)
+
+// Finds a maximum independent set in the provided graph: a set of mutually non-adjacent
+// vertices, with as many vertices as any other such set. If there are several, which
+// is returned is arbitrary. If the graph has no vertices, nil is returned.
+//
+// An independent set of a graph is a clique of its complement, so this runs Bron-Kerbosch
+// over the complement; it is practical only for small or dense graphs, whose complements
+// are sparse.
+func MaximumIndependentSet(g gogl.Graph) []gogl.Vertex {
+	return index(g).complement().maximum()
+}
+
+// Enumerates the maximal independent sets of the provided graph - those to which no
+// other vertex can be added - passing each to the provided step function, in the same
+// manner as MaximalCliques.
+func MaximalIndependentSets(g gogl.Graph, f CliqueStep) {
+	index(g).complement().maximalCliques(f)
+}
@@ -0,0 +1,40 @@
+package clique
+
+import (
+	stdrand "math/rand"
+
+	. "github.com/sdboyer/gocheck"
+	"github.com/sdboyer/gogl"
+	"github.com/sdboyer/gogl/graph/al"
+)
+
+type IndependentSuite struct{}
+
+var _ = Suite(&IndependentSuite{})
+
+func (s *IndependentSuite) TestMaximumIndependentSet(c *C) {
+	g := gogl.Spec().Using(cliqueEdgeSet).Create(al.G)
+
+	// one vertex from the 4-clique, plus e, plus g
+	set := MaximumIndependentSet(g)
+	c.Assert(set, HasLen, 3)
+	c.Assert(set, Contains, "e")
+	c.Assert(set, Contains, "g")
+	for i, u := range set {
+		for _, v := range set[:i] {
+			c.Assert(g.HasEdge(gogl.NewEdge(u, v)), Equals, false)
+		}
+	}
+
+	c.Assert(MaximumIndependentSet(gogl.Spec().Create(al.G)), IsNil)
+}
+
+func (s *IndependentSuite) TestMaximalIndependentSetsRandom(c *C) {
+	r := stdrand.New(stdrand.NewSource(17))
+	for iter := 0; iter < 20; iter++ {
+		g, adj := randomGraph(r, 10, 0.4)
+		expected, largest := bruteMaximal(10, func(i, j int) bool { return !adj[i][j] })
+		c.Assert(collect(g, MaximalIndependentSets), DeepEquals, expected, Commentf("iteration %d", iter))
+		c.Assert(MaximumIndependentSet(g), HasLen, largest)
+	}
+}
@@ -0,0 +1,155 @@
+package coloring
+
+import (
+	"fmt"
+
+	"github.com/sdboyer/gogl"
+)
+
+// Calculates the chromatic number of the provided graph - the fewest colors with which
+// it can be properly colored - and returns it along with a coloring that achieves it.
+//
+// This is an exact branch and bound search, following the DSatur heuristic to choose
+// which vertex to branch on, and pruning any branch that cannot improve on the best
+// coloring found so far. Graph coloring is NP-hard, so the search takes exponential
+// time in the worst case; it is practical only for small graphs, of perhaps up to a
+// hundred vertices, depending on their structure.
+//
+// If the graph contains a loop, it has no proper coloring, and an error is returned.
+func Chromatic(g gogl.Graph) (k int, coloring map[gogl.Vertex]int, err error) {
+	g.Edges(func(e gogl.Edge) (terminate bool) {
+		if u, v := e.Both(); u == v {
+			err = fmt.Errorf("Graph contains a loop at vertex %v, and so cannot be properly colored.", u)
+			return true
+		}
+		return
+	})
+
+	if err != nil {
+		return 0, nil, err
+	}
+
+	ix := index(g)
+	n := len(ix.vertices)
+
+	// DSatur provides the initial upper bound.
+	best := ix.dsatur()
+	cs := &chromaticSearch{
+		ix:     ix,
+		colors: make([]int, n),
+		count:  make([][]int, n),
+		best:   best,
+		bestK:  numColors(best),
+	}
+
+	for v := range cs.colors {
+		cs.colors[v] = -1
+		cs.count[v] = make([]int, cs.bestK)
+		if len(ix.adj[v]) > 0 {
+			cs.lowerK = 2
+		} else if cs.lowerK == 0 {
+			cs.lowerK = 1
+		}
+	}
+
+	if cs.bestK > cs.lowerK {
+		cs.search(0, 0)
+	}
+
+	return cs.bestK, ix.coloring(cs.best), nil
+}
+
+// Returns the number of colors used by a dense coloring, given that it uses colors 0
+// through k-1.
+func numColors(colors []int) (k int) {
+	for _, c := range colors {
+		if c+1 > k {
+			k = c + 1
+		}
+	}
+
+	return k
+}
+
+// State for an exact chromatic number search.
+type chromaticSearch struct {
+	ix *indexed
+	// The color of each vertex on the current branch; -1 if uncolored.
+	colors []int
+	// For each vertex, how many of its neighbors currently use each color. Colors at or
+	// above the best known count are never used, so these are never exceeded.
+	count [][]int
+	// The best coloring found so far, and the number of colors it uses.
+	best  []int
+	bestK int
+	// A trivial lower bound - 1 for any vertices, 2 for any edges; the search stops as
+	// soon as it is met.
+	lowerK int
+}
+
+// Extends the current branch, in which colored vertices have been assigned using k
+// colors, returning true if the search may stop.
+func (cs *chromaticSearch) search(colored, k int) (done bool) {
+	if colored == len(cs.colors) {
+		cs.best = append(cs.best[:0], cs.colors...)
+		cs.bestK = k
+		return cs.bestK <= cs.lowerK
+	}
+
+	v := cs.mostSaturated()
+
+	// Try each color already in use, then a single new one; all unused colors are
+	// interchangeable, so trying more than one would be redundant. Colors that would
+	// not improve on the best coloring are pruned.
+	for c := 0; c <= k && c < cs.bestK-1; c++ {
+		if cs.count[v][c] > 0 {
+			continue
+		}
+
+		cs.colors[v] = c
+		for _, w := range cs.ix.adj[v] {
+			cs.count[w][c]++
+		}
+
+		next := k
+		if c == k {
+			next = k + 1
+		}
+		done = cs.search(colored+1, next)
+
+		for _, w := range cs.ix.adj[v] {
+			cs.count[w][c]--
+		}
+		cs.colors[v] = -1
+
+		if done {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Selects the uncolored vertex whose neighbors use the most distinct colors, breaking
+// ties by degree.
+func (cs *chromaticSearch) mostSaturated() int {
+	v, vsat := -1, -1
+	for u, c := range cs.colors {
+		if c != -1 {
+			continue
+		}
+
+		sat := 0
+		for _, n := range cs.count[u] {
+			if n > 0 {
+				sat++
+			}
+		}
+
+		if sat > vsat || (sat == vsat && len(cs.ix.adj[u]) > len(cs.ix.adj[v])) {
+			v, vsat = u, sat
+		}
+	}
+
+	return v
+}
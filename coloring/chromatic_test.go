@@ -0,0 +1,92 @@
+package coloring
+
+import (
+	stdrand "math/rand"
+
+	. "github.com/sdboyer/gocheck"
+	"github.com/sdboyer/gogl"
+	"github.com/sdboyer/gogl/graph/al"
+)
+
+type ChromaticSuite struct{}
+
+var _ = Suite(&ChromaticSuite{})
+
+// Determines whether the graph can be properly colored with k colors, by exhaustive search.
+func colorable(ix *indexed, colors []int, v, k int) bool {
+	if v == len(colors) {
+		return true
+	}
+
+outer:
+	for c := 0; c < k; c++ {
+		for _, w := range ix.adj[v] {
+			if w < v && colors[w] == c {
+				continue outer
+			}
+		}
+		colors[v] = c
+		if colorable(ix, colors, v+1, k) {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *ChromaticSuite) TestChromatic(c *C) {
+	g := gogl.Spec().Using(petersenEdgeSet).Create(al.G)
+	k, coloring, err := Chromatic(g)
+	c.Assert(err, IsNil)
+	c.Assert(k, Equals, 3)
+	c.Assert(maxColor(coloring), Equals, 3)
+	c.Assert(Validate(g, coloring), IsNil)
+
+	// odd cycles need three colors, even cycles two
+	for n, expected := range map[int]int{5: 3, 6: 2} {
+		cycle := gogl.Spec().Mutable().Create(al.G).(gogl.MutableGraph)
+		for i := 0; i < n; i++ {
+			cycle.AddEdges(gogl.NewEdge(i, (i+1)%n))
+		}
+		k, _, _ = Chromatic(cycle.(gogl.Graph))
+		c.Assert(k, Equals, expected, Commentf("cycle of %d", n))
+	}
+
+	// complete graph on 6 vertices
+	complete := gogl.Spec().Mutable().Create(al.G).(gogl.MutableGraph)
+	for i := 0; i < 6; i++ {
+		for j := 0; j < i; j++ {
+			complete.AddEdges(gogl.NewEdge(i, j))
+		}
+	}
+	k, _, _ = Chromatic(complete.(gogl.Graph))
+	c.Assert(k, Equals, 6)
+}
+
+func (s *ChromaticSuite) TestChromaticTrivial(c *C) {
+	k, coloring, err := Chromatic(gogl.Spec().Create(al.G))
+	c.Assert(err, IsNil)
+	c.Assert(k, Equals, 0)
+	c.Assert(coloring, HasLen, 0)
+
+	g := gogl.Spec().Mutable().Create(al.G).(gogl.MutableGraph)
+	g.EnsureVertex("a", "b")
+	k, coloring, err = Chromatic(g.(gogl.Graph))
+	c.Assert(err, IsNil)
+	c.Assert(k, Equals, 1)
+	c.Assert(coloring, DeepEquals, map[gogl.Vertex]int{"a": 0, "b": 0})
+}
+
+func (s *ChromaticSuite) TestChromaticRandom(c *C) {
+	r := stdrand.New(stdrand.NewSource(9))
+	for iter := 0; iter < 30; iter++ {
+		g := randomGraph(r, 12, 0.45)
+		k, coloring, err := Chromatic(g)
+		c.Assert(err, IsNil)
+		c.Assert(Validate(g, coloring), IsNil)
+		c.Assert(maxColor(coloring), Equals, k)
+
+		ix := index(g)
+		c.Assert(colorable(ix, make([]int, 12), 0, k), Equals, true)
+		c.Assert(colorable(ix, make([]int, 12), 0, k-1), Equals, false, Commentf("iteration %d: %d colors", iter, k))
+	}
+}
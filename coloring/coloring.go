@@ -0,0 +1,270 @@
+// Contains algos and logic related to vertex coloring.
+//
+// A coloring maps every vertex in a graph to a color, represented as a non-negative
+// int; it is proper if no two adjacent vertices share a color. Colorings produced here
+// always use colors 0 through k-1, for some k.
+//
+// All algorithms here treat the graph as undirected; for a digraph, arc directionality
+// is ignored. A vertex with a loop is adjacent to itself, and so cannot be properly
+// colored; loops are otherwise ignored.
+package coloring
+
+import (
+	"container/heap"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/sdboyer/gogl"
+)
+
+// A Strategy identifies the order in which a greedy coloring visits vertices.
+type Strategy int
+
+const (
+	// LargestFirst visits vertices in order of decreasing degree, on the basis that
+	// the most constrained vertices are best colored while the most colors remain
+	// available. It runs in O(V log V + E) time.
+	LargestFirst Strategy = iota
+	// SmallestLast repeatedly removes a vertex of minimum degree from the graph, then
+	// visits vertices in the reverse of their removal order. It uses at most one more
+	// color than the graph's degeneracy, and runs in O((V + E) log V) time.
+	SmallestLast
+	// DSatur always visits next the vertex whose neighbors already use the most
+	// distinct colors, breaking ties by degree. It is exact for bipartite graphs, and
+	// tends to use the fewest colors of the three, but runs in O(V^2 + E) time.
+	DSatur
+)
+
+// Colors the provided graph greedily, visiting vertices in the order determined by
+// the given strategy and assigning each the smallest color not used by any of its
+// already-colored neighbors.
+//
+// The resulting coloring is always proper (loops aside), but need not use the fewest
+// colors possible; see Chromatic for an exact solution.
+func Greedy(g gogl.Graph, s Strategy) (map[gogl.Vertex]int, error) {
+	ix := index(g)
+
+	var colors []int
+	switch s {
+	case LargestFirst:
+		colors = ix.colorInOrder(ix.largestFirst())
+	case SmallestLast:
+		colors = ix.colorInOrder(ix.smallestLast())
+	case DSatur:
+		colors = ix.dsatur()
+	default:
+		return nil, errors.New("Unrecognized coloring strategy.")
+	}
+
+	return ix.coloring(colors), nil
+}
+
+// Checks that the provided coloring is a proper coloring of the provided graph: that
+// every vertex in the graph has a non-negative color, and that no two adjacent
+// vertices share a color.
+//
+// Returns nil if the coloring is proper, or an error describing the first violation
+// found otherwise. Colors for vertices not in the graph are ignored.
+func Validate(g gogl.Graph, coloring map[gogl.Vertex]int) (err error) {
+	g.Vertices(func(v gogl.Vertex) (terminate bool) {
+		c, exists := coloring[v]
+		if !exists {
+			err = fmt.Errorf("Vertex %v is not colored.", v)
+		} else if c < 0 {
+			err = fmt.Errorf("Vertex %v has negative color %d.", v, c)
+		}
+		return err != nil
+	})
+
+	if err != nil {
+		return err
+	}
+
+	g.Edges(func(e gogl.Edge) (terminate bool) {
+		u, v := e.Both()
+		if coloring[u] == coloring[v] {
+			err = fmt.Errorf("Adjacent vertices %v and %v share color %d.", u, v, coloring[u])
+			return true
+		}
+		return
+	})
+
+	return err
+}
+
+// A dense, index-based snapshot of a graph's adjacencies, without loops or duplicates.
+type indexed struct {
+	vertices []gogl.Vertex
+	adj      [][]int
+}
+
+func index(g gogl.Graph) *indexed {
+	ix := &indexed{}
+	idx := make(map[gogl.Vertex]int)
+	g.Vertices(func(v gogl.Vertex) (terminate bool) {
+		idx[v] = len(ix.vertices)
+		ix.vertices = append(ix.vertices, v)
+		return
+	})
+
+	seen := make(map[[2]int]bool)
+	ix.adj = make([][]int, len(ix.vertices))
+	g.Edges(func(e gogl.Edge) (terminate bool) {
+		u, v := e.Both()
+		i, j := idx[u], idx[v]
+		if i > j {
+			i, j = j, i
+		}
+		if i != j && !seen[[2]int{i, j}] {
+			seen[[2]int{i, j}] = true
+			ix.adj[i] = append(ix.adj[i], j)
+			ix.adj[j] = append(ix.adj[j], i)
+		}
+		return
+	})
+
+	return ix
+}
+
+// Converts a dense coloring back into a vertex-keyed map.
+func (ix *indexed) coloring(colors []int) map[gogl.Vertex]int {
+	coloring := make(map[gogl.Vertex]int, len(colors))
+	for i, v := range ix.vertices {
+		coloring[v] = colors[i]
+	}
+
+	return coloring
+}
+
+// Returns the smallest color not used by any colored neighbor of v. Uncolored vertices
+// have a color of -1.
+func (ix *indexed) firstFree(v int, colors []int) int {
+	used := make([]bool, len(ix.adj[v])+1)
+	for _, w := range ix.adj[v] {
+		if c := colors[w]; c >= 0 && c < len(used) {
+			used[c] = true
+		}
+	}
+
+	c := 0
+	for used[c] {
+		c++
+	}
+
+	return c
+}
+
+func (ix *indexed) colorInOrder(order []int) []int {
+	colors := make([]int, len(ix.vertices))
+	for i := range colors {
+		colors[i] = -1
+	}
+
+	for _, v := range order {
+		colors[v] = ix.firstFree(v, colors)
+	}
+
+	return colors
+}
+
+func (ix *indexed) largestFirst() []int {
+	order := make([]int, len(ix.vertices))
+	for i := range order {
+		order[i] = i
+	}
+
+	sort.SliceStable(order, func(a, b int) bool {
+		return len(ix.adj[order[a]]) > len(ix.adj[order[b]])
+	})
+
+	return order
+}
+
+func (ix *indexed) smallestLast() []int {
+	n := len(ix.vertices)
+	degree := make([]int, n)
+	removed := make([]bool, n)
+	h := &dheap{}
+	for v := range ix.adj {
+		degree[v] = len(ix.adj[v])
+		heap.Push(h, ditem{v, degree[v]})
+	}
+
+	order := make([]int, n)
+	for i := n - 1; i >= 0; {
+		item := heap.Pop(h).(ditem)
+		// Skip entries superseded by a later degree decrease.
+		if removed[item.v] || item.degree != degree[item.v] {
+			continue
+		}
+
+		removed[item.v] = true
+		order[i] = item.v
+		i--
+
+		for _, w := range ix.adj[item.v] {
+			if !removed[w] {
+				degree[w]--
+				heap.Push(h, ditem{w, degree[w]})
+			}
+		}
+	}
+
+	return order
+}
+
+func (ix *indexed) dsatur() []int {
+	n := len(ix.vertices)
+	colors := make([]int, n)
+	neighborColors := make([]map[int]bool, n)
+	for i := range colors {
+		colors[i] = -1
+		neighborColors[i] = make(map[int]bool)
+	}
+
+	for colored := 0; colored < n; colored++ {
+		v := -1
+		for u := range colors {
+			if colors[u] != -1 {
+				continue
+			}
+			if v == -1 || len(neighborColors[u]) > len(neighborColors[v]) ||
+				(len(neighborColors[u]) == len(neighborColors[v]) && len(ix.adj[u]) > len(ix.adj[v])) {
+				v = u
+			}
+		}
+
+		colors[v] = ix.firstFree(v, colors)
+		for _, w := range ix.adj[v] {
+			neighborColors[w][colors[v]] = true
+		}
+	}
+
+	return colors
+}
+
+type ditem struct {
+	v, degree int
+}
+
+// A min-heap of vertices by degree. Implements heap.Interface.
+type dheap []ditem
+
+func (h dheap) Len() int { return len(h) }
+
+func (h dheap) Less(i, j int) bool { return h[i].degree < h[j].degree }
+
+func (h dheap) Swap(i, j int) { h[i], h[j] = h[j], h[i] }
+
+func (h *dheap) Push(x interface{}) {
+	*h = append(*h, x.(ditem))
+}
+
+func (h *dheap) Pop() interface{} {
+	old := *h
+	n := len(old)
+	item := old[n-1]
+	*h = old[:n-1]
+	return item
+}
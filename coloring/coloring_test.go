@@ -0,0 +1,122 @@
+package coloring
+
+import (
+	stdrand "math/rand"
+	"testing"
+
+	. "github.com/sdboyer/gocheck"
+	"github.com/sdboyer/gogl"
+	"github.com/sdboyer/gogl/graph/al"
+)
+
+// Hook gocheck into the go test runner
+func Test(t *testing.T) { TestingT(t) }
+
+var strategies = map[string]Strategy{
+	"LargestFirst": LargestFirst,
+	"SmallestLast": SmallestLast,
+	"DSatur":       DSatur,
+}
+
+// The Petersen graph: 3-regular, with chromatic number 3.
+var petersenEdgeSet = gogl.EdgeList{
+	gogl.NewEdge(0, 1), gogl.NewEdge(1, 2), gogl.NewEdge(2, 3), gogl.NewEdge(3, 4), gogl.NewEdge(4, 0),
+	gogl.NewEdge(0, 5), gogl.NewEdge(1, 6), gogl.NewEdge(2, 7), gogl.NewEdge(3, 8), gogl.NewEdge(4, 9),
+	gogl.NewEdge(5, 7), gogl.NewEdge(7, 9), gogl.NewEdge(9, 6), gogl.NewEdge(6, 8), gogl.NewEdge(8, 5),
+}
+
+// Generates a random undirected graph, with each possible edge present with probability p.
+func randomGraph(r *stdrand.Rand, n int, p float64) gogl.Graph {
+	g := gogl.Spec().Mutable().Create(al.G).(gogl.MutableGraph)
+	for i := 0; i < n; i++ {
+		g.EnsureVertex(i)
+		for j := 0; j < i; j++ {
+			if r.Float64() < p {
+				g.AddEdges(gogl.NewEdge(i, j))
+			}
+		}
+	}
+	return g.(gogl.Graph)
+}
+
+func maxColor(coloring map[gogl.Vertex]int) (k int) {
+	for _, c := range coloring {
+		if c+1 > k {
+			k = c + 1
+		}
+	}
+	return k
+}
+
+type ColoringSuite struct{}
+
+var _ = Suite(&ColoringSuite{})
+
+func (s *ColoringSuite) TestGreedy(c *C) {
+	g := gogl.Spec().Using(petersenEdgeSet).Create(al.G)
+	for name, strategy := range strategies {
+		coloring, err := Greedy(g, strategy)
+		c.Assert(err, IsNil)
+		c.Assert(Validate(g, coloring), IsNil, Commentf("strategy %s", name))
+		c.Assert(coloring, HasLen, 10)
+		// Brooks' theorem; any greedy coloring uses at most max degree + 1 colors
+		c.Assert(maxColor(coloring) <= 4, Equals, true, Commentf("strategy %s", name))
+	}
+
+	_, err := Greedy(g, Strategy(42))
+	c.Assert(err, ErrorMatches, "Unrecognized coloring strategy.")
+}
+
+func (s *ColoringSuite) TestGreedyRandom(c *C) {
+	r := stdrand.New(stdrand.NewSource(5))
+	for iter := 0; iter < 30; iter++ {
+		g := randomGraph(r, 25, 0.3)
+		for name, strategy := range strategies {
+			coloring, err := Greedy(g, strategy)
+			c.Assert(err, IsNil)
+			c.Assert(Validate(g, coloring), IsNil, Commentf("iteration %d, strategy %s", iter, name))
+		}
+	}
+}
+
+func (s *ColoringSuite) TestDSaturBipartite(c *C) {
+	// A crown graph: complete bipartite minus a perfect matching. Greedy colorings in
+	// a bad order can use n colors; DSatur always uses two.
+	g := gogl.Spec().Mutable().Create(al.G).(gogl.MutableGraph)
+	for i := 0; i < 6; i++ {
+		for j := 0; j < 6; j++ {
+			if i != j {
+				g.AddEdges(gogl.NewEdge(i, 10+j))
+			}
+		}
+	}
+
+	coloring, err := Greedy(g.(gogl.Graph), DSatur)
+	c.Assert(err, IsNil)
+	c.Assert(maxColor(coloring), Equals, 2)
+}
+
+func (s *ColoringSuite) TestSmallestLastDegeneracy(c *C) {
+	// A tree is 1-degenerate, so smallest-last needs only two colors.
+	g := gogl.Spec().Mutable().Create(al.G).(gogl.MutableGraph)
+	for i := 1; i < 20; i++ {
+		g.AddEdges(gogl.NewEdge(i, (i-1)/2))
+	}
+
+	coloring, err := Greedy(g.(gogl.Graph), SmallestLast)
+	c.Assert(err, IsNil)
+	c.Assert(maxColor(coloring), Equals, 2)
+}
+
+func (s *ColoringSuite) TestValidate(c *C) {
+	g := gogl.Spec().Using(gogl.EdgeList{
+		gogl.NewEdge("a", "b"),
+		gogl.NewEdge("b", "c"),
+	}).Create(al.G)
+
+	c.Assert(Validate(g, map[gogl.Vertex]int{"a": 0, "b": 1, "c": 0}), IsNil)
+	c.Assert(Validate(g, map[gogl.Vertex]int{"a": 0, "b": 1, "c": 0, "extra": 0}), IsNil)
+	c.Assert(Validate(g, map[gogl.Vertex]int{"a": 0, "b": 1}), ErrorMatches, "Vertex c is not colored.")
+	c.Assert(Validate(g, map[gogl.Vertex]int{"a": 0, "b": -1, "c": 0}), ErrorMatches, "Vertex b has negative color -1.")
+	c.Assert(Validate(g, map[gogl.Vertex]int{"a": 0, "b": 1, "c": 1}), ErrorMatches, "Adjacent vertices [bc] and [bc] share color 1.")
+}
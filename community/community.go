@@ -0,0 +1,182 @@
+// Contains algos and logic for detecting communities: groups of vertices more densely
+// connected to one another than to the rest of the graph.
+//
+// A partition assigns every vertex in a graph to a community, identified by an int.
+// Partitions produced here number their communities from 0 through k-1.
+//
+// All algorithms here treat the graph as undirected; for a digraph, arc directionality
+// is ignored. Weighted variants read edge weights by type asserting to WeightedEdge, and
+// return an error if any edge in the graph is not weighted, or has a negative weight.
+package community
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/sdboyer/gogl"
+)
+
+// Calculates the modularity of the provided partition of the provided graph: the
+// fraction of edges that fall within communities, less the fraction that would be
+// expected to if edges were placed at random while preserving vertex degrees.
+//
+// Modularity ranges from -0.5 to 1; higher scores indicate stronger community structure.
+// A graph with no edges has a modularity of 0 under any partition. An error is returned
+// if any vertex in the graph is not assigned to a community.
+func Modularity(g gogl.Graph, partition map[gogl.Vertex]int) (float64, error) {
+	ix, _ := index(g, false)
+	return ix.modularityOf(partition)
+}
+
+// Calculates modularity as per Modularity, with each edge counting in proportion to
+// its weight.
+func WeightedModularity(g gogl.WeightedGraph, partition map[gogl.Vertex]int) (float64, error) {
+	ix, err := index(g, true)
+	if err != nil {
+		return 0, err
+	}
+
+	return ix.modularityOf(partition)
+}
+
+// An edge between dense vertex indices.
+type indexedEdge struct {
+	to int
+	w  float64
+}
+
+// A dense, index-based snapshot of a weighted undirected graph. Louvain's aggregation
+// step produces further graphs of the same form, whose vertices are communities.
+type indexed struct {
+	// The original vertices; only set for a snapshot of a gogl graph.
+	vertices []gogl.Vertex
+	index    map[gogl.Vertex]int
+	// Each vertex's edges to other vertices. Each edge appears at both of its ends.
+	adj [][]indexedEdge
+	// The total weight of each vertex's loops, counted twice, as a loop contributes to
+	// its vertex's degree at both ends.
+	loop []float64
+	// The weighted degree of each vertex, including loops.
+	degree []float64
+	// The sum of all degrees; twice the total edge weight.
+	total float64
+}
+
+func index(g gogl.Graph, weighted bool) (*indexed, error) {
+	ix := &indexed{index: make(map[gogl.Vertex]int)}
+	g.Vertices(func(v gogl.Vertex) (terminate bool) {
+		ix.index[v] = len(ix.vertices)
+		ix.vertices = append(ix.vertices, v)
+		return
+	})
+
+	n := len(ix.vertices)
+	ix.adj = make([][]indexedEdge, n)
+	ix.loop = make([]float64, n)
+	ix.degree = make([]float64, n)
+
+	var err error
+	g.Edges(func(e gogl.Edge) (terminate bool) {
+		w := 1.0
+		u, v := e.Both()
+		if weighted {
+			we, ok := e.(gogl.WeightedEdge)
+			if !ok {
+				err = errors.New("Graph contains an edge that is not weighted.")
+				return true
+			}
+			if w = we.Weight(); w < 0 {
+				err = fmt.Errorf("Negative edge weight encountered between %v and %v; weights must be non-negative.", u, v)
+				return true
+			}
+		}
+
+		ix.addEdge(ix.index[u], ix.index[v], w)
+		return
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return ix, nil
+}
+
+// Creates an empty indexed graph with n vertices, for aggregation.
+func newIndexed(n int) *indexed {
+	return &indexed{
+		adj:    make([][]indexedEdge, n),
+		loop:   make([]float64, n),
+		degree: make([]float64, n),
+	}
+}
+
+func (ix *indexed) addEdge(i, j int, w float64) {
+	if i == j {
+		ix.loop[i] += 2 * w
+	} else {
+		ix.adj[i] = append(ix.adj[i], indexedEdge{to: j, w: w})
+		ix.adj[j] = append(ix.adj[j], indexedEdge{to: i, w: w})
+	}
+
+	ix.degree[i] += w
+	ix.degree[j] += w
+	ix.total += 2 * w
+}
+
+func (ix *indexed) modularityOf(partition map[gogl.Vertex]int) (float64, error) {
+	communities := make([]int, len(ix.vertices))
+	for i, v := range ix.vertices {
+		c, exists := partition[v]
+		if !exists {
+			return 0, fmt.Errorf("Vertex %v is not assigned to a community.", v)
+		}
+		communities[i] = c
+	}
+
+	return ix.modularity(communities), nil
+}
+
+// Calculates the modularity of a partition given as a community per vertex index.
+// Community identifiers may be any ints.
+func (ix *indexed) modularity(communities []int) float64 {
+	if ix.total == 0 {
+		return 0
+	}
+
+	internal := make(map[int]float64)
+	degree := make(map[int]float64)
+	for i, c := range communities {
+		degree[c] += ix.degree[i]
+		internal[c] += ix.loop[i]
+		for _, e := range ix.adj[i] {
+			if communities[e.to] == c {
+				internal[c] += e.w
+			}
+		}
+	}
+
+	var q float64
+	for c, d := range degree {
+		q += internal[c]/ix.total - (d/ix.total)*(d/ix.total)
+	}
+
+	return q
+}
+
+// Converts a community per vertex index into a vertex-keyed partition, renumbering
+// communities from 0 in order of first appearance.
+func (ix *indexed) partition(communities []int) map[gogl.Vertex]int {
+	renumber := make(map[int]int)
+	partition := make(map[gogl.Vertex]int, len(communities))
+	for i, c := range communities {
+		id, exists := renumber[c]
+		if !exists {
+			id = len(renumber)
+			renumber[c] = id
+		}
+		partition[ix.vertices[i]] = id
+	}
+
+	return partition
+}
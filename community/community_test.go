@@ -0,0 +1,176 @@
+package community
+
+import (
+	"math"
+	stdrand "math/rand"
+	"testing"
+
+	. "github.com/sdboyer/gocheck"
+	"github.com/sdboyer/gogl"
+	"github.com/sdboyer/gogl/graph/al"
+)
+
+// Hook gocheck into the go test runner
+func Test(t *testing.T) { TestingT(t) }
+
+// Two triangles, a-b-c and d-e-f, joined by a single edge between c and d.
+var barbellEdgeSet = gogl.EdgeList{
+	gogl.NewEdge("a", "b"),
+	gogl.NewEdge("b", "c"),
+	gogl.NewEdge("c", "a"),
+	gogl.NewEdge("c", "d"),
+	gogl.NewEdge("d", "e"),
+	gogl.NewEdge("e", "f"),
+	gogl.NewEdge("f", "d"),
+}
+
+// A weighted graph wrapper that yields unweighted edges.
+type unweighted struct {
+	gogl.WeightedGraph
+}
+
+func (g unweighted) Edges(f gogl.EdgeStep) {
+	g.WeightedGraph.Edges(func(e gogl.Edge) (terminate bool) {
+		return f(gogl.NewEdge(e.Both()))
+	})
+}
+
+// Builds a graph of k disjoint cliques of the given size, with vertices numbered
+// consecutively, so that vertex v belongs to clique v / size.
+func cliques(k, size int) gogl.MutableGraph {
+	g := gogl.Spec().Mutable().Create(al.G).(gogl.MutableGraph)
+	for i := 0; i < k*size; i++ {
+		g.EnsureVertex(i)
+		for j := i - i%size; j < i; j++ {
+			g.AddEdges(gogl.NewEdge(i, j))
+		}
+	}
+	return g
+}
+
+// Builds a random graph on n vertices, with each possible edge present with probability p.
+func randomGraph(r *stdrand.Rand, n int, p float64) gogl.MutableGraph {
+	g := gogl.Spec().Mutable().Create(al.G).(gogl.MutableGraph)
+	for i := 0; i < n; i++ {
+		g.EnsureVertex(i)
+		for j := 0; j < i; j++ {
+			if r.Float64() < p {
+				g.AddEdges(gogl.NewEdge(i, j))
+			}
+		}
+	}
+	return g
+}
+
+// Asserts that the partition covers exactly the graph's vertices, numbering its
+// communities from 0 through k-1, and that the score is the partition's modularity.
+func checkPartition(c *C, g gogl.Graph, partition map[gogl.Vertex]int, q float64) {
+	c.Assert(len(partition), Equals, gogl.Order(g))
+
+	used := make(map[int]bool)
+	g.Vertices(func(v gogl.Vertex) (terminate bool) {
+		id, exists := partition[v]
+		c.Assert(exists, Equals, true, Commentf("vertex %v", v))
+		used[id] = true
+		return
+	})
+	for id := range used {
+		c.Assert(id >= 0 && id < len(used), Equals, true, Commentf("community %d of %d", id, len(used)))
+	}
+
+	expected, err := Modularity(g, partition)
+	c.Assert(err, IsNil)
+	c.Assert(math.Abs(q-expected) < 1e-9, Equals, true, Commentf("got %v, want %v", q, expected))
+}
+
+// Asserts that the partition groups vertices exactly as the given sets do.
+func checkGroups(c *C, partition map[gogl.Vertex]int, groups ...[]gogl.Vertex) {
+	seen := make(map[int]bool)
+	for _, group := range groups {
+		id := partition[group[0]]
+		c.Assert(seen[id], Equals, false, Commentf("group %v shares community %d", group, id))
+		seen[id] = true
+		for _, v := range group {
+			c.Assert(partition[v], Equals, id, Commentf("vertex %v", v))
+		}
+	}
+	c.Assert(len(seen), Equals, len(groups))
+}
+
+type ModularitySuite struct{}
+
+var _ = Suite(&ModularitySuite{})
+
+func (s *ModularitySuite) TestModularity(c *C) {
+	g := gogl.Spec().Mutable().Using(barbellEdgeSet).Create(al.G).(gogl.MutableGraph)
+
+	q, err := Modularity(g, map[gogl.Vertex]int{"a": 0, "b": 0, "c": 0, "d": 1, "e": 1, "f": 1})
+	c.Assert(err, IsNil)
+	c.Assert(math.Abs(q-5.0/14) < 1e-9, Equals, true, Commentf("got %v", q))
+
+	// community identifiers are arbitrary
+	q, err = Modularity(g, map[gogl.Vertex]int{"a": -3, "b": -3, "c": -3, "d": 8, "e": 8, "f": 8})
+	c.Assert(err, IsNil)
+	c.Assert(math.Abs(q-5.0/14) < 1e-9, Equals, true, Commentf("got %v", q))
+
+	q, err = Modularity(g, map[gogl.Vertex]int{"a": 0, "b": 0, "c": 0, "d": 0, "e": 0, "f": 0})
+	c.Assert(err, IsNil)
+	c.Assert(math.Abs(q) < 1e-9, Equals, true, Commentf("got %v", q))
+
+	q, err = Modularity(g, map[gogl.Vertex]int{"a": 0, "b": 1, "c": 2, "d": 3, "e": 4, "f": 5})
+	c.Assert(err, IsNil)
+	c.Assert(math.Abs(q+34.0/196) < 1e-9, Equals, true, Commentf("got %v", q))
+
+	_, err = Modularity(g, map[gogl.Vertex]int{"a": 0, "b": 0, "c": 0, "d": 1, "e": 1})
+	c.Assert(err, ErrorMatches, "Vertex f is not assigned to a community.")
+
+	// an isolate contributes nothing, but must still be assigned
+	g.EnsureVertex("isolate")
+	q, err = Modularity(g, map[gogl.Vertex]int{"a": 0, "b": 0, "c": 0, "d": 1, "e": 1, "f": 1, "isolate": 2})
+	c.Assert(err, IsNil)
+	c.Assert(math.Abs(q-5.0/14) < 1e-9, Equals, true, Commentf("got %v", q))
+
+	q, err = Modularity(gogl.Spec().Create(al.G), map[gogl.Vertex]int{})
+	c.Assert(err, IsNil)
+	c.Assert(q, Equals, float64(0))
+}
+
+func (s *ModularitySuite) TestModularityDirected(c *C) {
+	var el gogl.ArcList
+	for _, e := range barbellEdgeSet {
+		el = append(el, gogl.NewArc(e.Both()))
+	}
+	g := gogl.Spec().Directed().Using(el).Create(al.G)
+
+	q, err := Modularity(g, map[gogl.Vertex]int{"a": 0, "b": 0, "c": 0, "d": 1, "e": 1, "f": 1})
+	c.Assert(err, IsNil)
+	c.Assert(math.Abs(q-5.0/14) < 1e-9, Equals, true, Commentf("got %v", q))
+}
+
+func (s *ModularitySuite) TestWeightedModularity(c *C) {
+	var el gogl.WeightedEdgeList
+	for _, e := range barbellEdgeSet {
+		u, v := e.Both()
+		if u == "c" && v == "d" {
+			el = append(el, gogl.NewWeightedEdge(u, v, 5))
+		} else {
+			el = append(el, gogl.NewWeightedEdge(u, v, 1))
+		}
+	}
+	g := gogl.Spec().Weighted().Using(el).Create(al.G).(gogl.WeightedGraph)
+	partition := map[gogl.Vertex]int{"a": 0, "b": 0, "c": 0, "d": 1, "e": 1, "f": 1}
+
+	// the heavy bridge makes the split much less compelling
+	q, err := WeightedModularity(g, partition)
+	c.Assert(err, IsNil)
+	c.Assert(math.Abs(q-1.0/22) < 1e-9, Equals, true, Commentf("got %v", q))
+
+	_, err = WeightedModularity(unweighted{g}, partition)
+	c.Assert(err, ErrorMatches, "Graph contains an edge that is not weighted.")
+
+	neg := gogl.Spec().Weighted().Using(gogl.WeightedEdgeList{
+		gogl.NewWeightedEdge("a", "b", -1),
+	}).Create(al.G).(gogl.WeightedGraph)
+	_, err = WeightedModularity(neg, map[gogl.Vertex]int{"a": 0, "b": 0})
+	c.Assert(err, ErrorMatches, "Negative edge weight encountered between .* and .*; weights must be non-negative.")
+}
@@ -0,0 +1,102 @@
+package community
+
+import (
+	stdrand "math/rand"
+
+	"github.com/sdboyer/gogl"
+)
+
+// The maximum number of passes label propagation makes over the graph. Propagation
+// almost always settles long before this; the limit guards against rare oscillation.
+const maxPropagationPasses = 100
+
+// Partitions the provided graph into communities by label propagation, returning the
+// partition along with its modularity.
+//
+// Every vertex starts with a label of its own. Then, visiting vertices in random order,
+// each adopts the label carried by the greatest number of its neighbors, breaking ties
+// at random, but keeping its own label where that is among the most common. This repeats
+// until a full pass changes no label; vertices sharing a label form a community.
+//
+// Label propagation runs in near-linear time, making it one of the fastest community
+// detection methods, but its results vary from run to run. The provided source of
+// randomness determines the visiting order and tie-breaking; given the same source state
+// and the same vertex enumeration order, the result is the same.
+func LabelPropagation(g gogl.Graph, src stdrand.Source) (map[gogl.Vertex]int, float64) {
+	ix, _ := index(g, false)
+	communities := ix.labelPropagation(stdrand.New(src))
+	return ix.partition(communities), ix.modularity(communities)
+}
+
+// Partitions the provided graph as per LabelPropagation, with each neighbor's label
+// counting in proportion to the weight of the edge to it.
+func WeightedLabelPropagation(g gogl.WeightedGraph, src stdrand.Source) (map[gogl.Vertex]int, float64, error) {
+	ix, err := index(g, true)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	communities := ix.labelPropagation(stdrand.New(src))
+	return ix.partition(communities), ix.modularity(communities), nil
+}
+
+func (ix *indexed) labelPropagation(r *stdrand.Rand) []int {
+	n := len(ix.adj)
+	labels := make([]int, n)
+	for i := range labels {
+		labels[i] = i
+	}
+
+	weight := make([]float64, n)
+	var touched, candidates []int
+
+	for pass := 0; pass < maxPropagationPasses; pass++ {
+		changed := false
+
+		for _, i := range r.Perm(n) {
+			if len(ix.adj[i]) == 0 {
+				continue
+			}
+
+			touched = touched[:0]
+			for _, e := range ix.adj[i] {
+				l := labels[e.to]
+				if weight[l] == 0 {
+					touched = append(touched, l)
+				}
+				weight[l] += e.w
+			}
+
+			var best float64
+			candidates = candidates[:0]
+			for _, l := range touched {
+				switch {
+				case weight[l] > best+epsilon:
+					best = weight[l]
+					candidates = append(candidates[:0], l)
+				case weight[l] > best-epsilon:
+					candidates = append(candidates, l)
+				}
+			}
+
+			keep := false
+			for _, l := range candidates {
+				keep = keep || l == labels[i]
+			}
+			if !keep && len(candidates) > 0 {
+				labels[i] = candidates[r.Intn(len(candidates))]
+				changed = true
+			}
+
+			for _, l := range touched {
+				weight[l] = 0
+			}
+		}
+
+		if !changed {
+			break
+		}
+	}
+
+	return labels
+}
@@ -0,0 +1,83 @@
+package community
+
+import (
+	stdrand "math/rand"
+
+	. "github.com/sdboyer/gocheck"
+	"github.com/sdboyer/gogl"
+	"github.com/sdboyer/gogl/graph/al"
+)
+
+type LabelPropagationSuite struct{}
+
+var _ = Suite(&LabelPropagationSuite{})
+
+func (s *LabelPropagationSuite) TestLabelPropagation(c *C) {
+	g := cliques(3, 4)
+	g.EnsureVertex("isolate")
+
+	for seed := int64(0); seed < 10; seed++ {
+		partition, q := LabelPropagation(g, stdrand.NewSource(seed))
+		checkPartition(c, g, partition, q)
+		checkGroups(c, partition,
+			[]gogl.Vertex{0, 1, 2, 3},
+			[]gogl.Vertex{4, 5, 6, 7},
+			[]gogl.Vertex{8, 9, 10, 11},
+			[]gogl.Vertex{"isolate"},
+		)
+	}
+
+	partition, q := LabelPropagation(gogl.Spec().Create(al.G), stdrand.NewSource(1))
+	c.Assert(partition, HasLen, 0)
+	c.Assert(q, Equals, float64(0))
+}
+
+func (s *LabelPropagationSuite) TestWeightedLabelPropagation(c *C) {
+	// A square; each vertex always follows its heavy neighbor.
+	g := gogl.Spec().Weighted().Using(gogl.WeightedEdgeList{
+		gogl.NewWeightedEdge("a", "b", 5),
+		gogl.NewWeightedEdge("b", "c", 1),
+		gogl.NewWeightedEdge("c", "d", 5),
+		gogl.NewWeightedEdge("d", "a", 1),
+	}).Create(al.G).(gogl.WeightedGraph)
+
+	for seed := int64(0); seed < 10; seed++ {
+		partition, q, err := WeightedLabelPropagation(g, stdrand.NewSource(seed))
+		c.Assert(err, IsNil)
+		checkGroups(c, partition, []gogl.Vertex{"a", "b"}, []gogl.Vertex{"c", "d"})
+
+		expected, _ := WeightedModularity(g, partition)
+		c.Assert(q, Equals, expected)
+	}
+
+	_, _, err := WeightedLabelPropagation(unweighted{g}, stdrand.NewSource(1))
+	c.Assert(err, ErrorMatches, "Graph contains an edge that is not weighted.")
+}
+
+func (s *LabelPropagationSuite) TestLabelPropagationRandom(c *C) {
+	r := stdrand.New(stdrand.NewSource(5))
+	for iter := 0; iter < 20; iter++ {
+		g := randomGraph(r, 30, 0.15)
+
+		partition, q := LabelPropagation(g, stdrand.NewSource(int64(iter)))
+		checkPartition(c, g, partition, q)
+
+		// on convergence, each vertex carries one of the most common labels among
+		// its neighbors
+		g.Vertices(func(v gogl.Vertex) (terminate bool) {
+			counts := make(map[int]int)
+			var best int
+			g.AdjacentTo(v, func(u gogl.Vertex) (terminate bool) {
+				counts[partition[u]]++
+				if counts[partition[u]] > best {
+					best = counts[partition[u]]
+				}
+				return
+			})
+			if best > 0 {
+				c.Assert(counts[partition[v]], Equals, best, Commentf("iteration %d, vertex %v", iter, v))
+			}
+			return
+		})
+	}
+}
@@ -0,0 +1,161 @@
+package community
+
+import (
+	"github.com/sdboyer/gogl"
+)
+
+// Partitions the provided graph into communities using the Louvain method, returning
+// the partition along with its modularity.
+//
+// The Louvain method greedily optimizes modularity. It repeatedly moves individual
+// vertices into whichever neighboring community most increases modularity until no move
+// helps, then contracts each community into a single vertex and repeats on the result,
+// stopping once no vertex moves. It runs in roughly O(E log V) time in practice, and so
+// scales well to large graphs; the result is a local, not global, optimum.
+//
+// Vertices are considered in the order the graph enumerates them, so the result is
+// deterministic only if that order is.
+func Louvain(g gogl.Graph) (map[gogl.Vertex]int, float64) {
+	ix, _ := index(g, false)
+	communities := ix.louvain()
+	return ix.partition(communities), ix.modularity(communities)
+}
+
+// Partitions the provided graph as per Louvain, with each edge counting in proportion
+// to its weight.
+func WeightedLouvain(g gogl.WeightedGraph) (map[gogl.Vertex]int, float64, error) {
+	ix, err := index(g, true)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	communities := ix.louvain()
+	return ix.partition(communities), ix.modularity(communities), nil
+}
+
+// Gains smaller than this are treated as rounding error, so that the local moving
+// phase cannot cycle between equivalent assignments.
+const epsilon = 1e-12
+
+func (ix *indexed) louvain() []int {
+	// The community of each original vertex, as a vertex of the current level.
+	membership := make([]int, len(ix.adj))
+	for i := range membership {
+		membership[i] = i
+	}
+
+	level := ix
+	for {
+		communities, k, moved := level.localMoves()
+		if !moved {
+			break
+		}
+
+		for i, c := range membership {
+			membership[i] = communities[c]
+		}
+		level = level.aggregate(communities, k)
+	}
+
+	return membership
+}
+
+// Moves each vertex into the neighboring community that most increases modularity,
+// starting from singletons, until no move helps. Returns the resulting communities,
+// numbered 0 through k-1, and whether any vertex moved.
+func (ix *indexed) localMoves() (communities []int, k int, moved bool) {
+	n := len(ix.adj)
+	communities = make([]int, n)
+	// The total degree of each community.
+	tot := make([]float64, n)
+	for i := range communities {
+		communities[i] = i
+		tot[i] = ix.degree[i]
+	}
+
+	if ix.total == 0 {
+		return communities, n, false
+	}
+
+	// Weight from the current vertex to each neighboring community, and which
+	// communities were touched, so the weights can be cheaply reset.
+	weight := make([]float64, n)
+	var touched []int
+
+	for improved := true; improved; {
+		improved = false
+
+		for i := range ix.adj {
+			ci, ki := communities[i], ix.degree[i]
+
+			touched = append(touched[:0], ci)
+			for _, e := range ix.adj[i] {
+				c := communities[e.to]
+				if weight[c] == 0 {
+					touched = append(touched, c)
+				}
+				weight[c] += e.w
+			}
+
+			// Take i out of its community, then find the best one to put it in; the
+			// gain of joining c is proportional to this, which is 0 for isolation.
+			tot[ci] -= ki
+			best, bestGain := ci, weight[ci]-tot[ci]*ki/ix.total
+			for _, c := range touched {
+				if gain := weight[c] - tot[c]*ki/ix.total; gain > bestGain+epsilon {
+					best, bestGain = c, gain
+				}
+			}
+			tot[best] += ki
+			communities[i] = best
+
+			if best != ci {
+				improved, moved = true, true
+			}
+
+			for _, c := range touched {
+				weight[c] = 0
+			}
+		}
+	}
+
+	// Renumber the surviving communities densely.
+	renumber := make(map[int]int)
+	for i, c := range communities {
+		id, exists := renumber[c]
+		if !exists {
+			id = len(renumber)
+			renumber[c] = id
+		}
+		communities[i] = id
+	}
+
+	return communities, len(renumber), moved
+}
+
+// Contracts each community into a single vertex. Edges within a community become a
+// loop on its vertex; edges between communities are merged.
+func (ix *indexed) aggregate(communities []int, k int) *indexed {
+	agg := newIndexed(k)
+
+	merged := make(map[[2]int]float64)
+	for i, edges := range ix.adj {
+		ci := communities[i]
+		agg.loop[ci] += ix.loop[i]
+		agg.degree[ci] += ix.loop[i]
+		agg.total += ix.loop[i]
+
+		for _, e := range edges {
+			// Each edge is listed at both ends; take it once.
+			if i < e.to {
+				merged[[2]int{ci, communities[e.to]}] += e.w
+			}
+		}
+	}
+
+	for pair, w := range merged {
+		agg.addEdge(pair[0], pair[1], w)
+	}
+
+	return agg
+}
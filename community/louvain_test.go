@@ -0,0 +1,88 @@
+package community
+
+import (
+	stdrand "math/rand"
+
+	. "github.com/sdboyer/gocheck"
+	"github.com/sdboyer/gogl"
+	"github.com/sdboyer/gogl/graph/al"
+)
+
+type LouvainSuite struct{}
+
+var _ = Suite(&LouvainSuite{})
+
+func (s *LouvainSuite) TestLouvain(c *C) {
+	g := gogl.Spec().Mutable().Using(barbellEdgeSet).Create(al.G).(gogl.MutableGraph)
+	g.EnsureVertex("isolate")
+
+	partition, q := Louvain(g)
+	checkPartition(c, g, partition, q)
+	checkGroups(c, partition,
+		[]gogl.Vertex{"a", "b", "c"},
+		[]gogl.Vertex{"d", "e", "f"},
+		[]gogl.Vertex{"isolate"},
+	)
+
+	partition, q = Louvain(gogl.Spec().Create(al.G))
+	c.Assert(partition, HasLen, 0)
+	c.Assert(q, Equals, float64(0))
+}
+
+func (s *LouvainSuite) TestLouvainRingOfCliques(c *C) {
+	// Six cliques of five, each joined to the next by a single edge. Merging any two
+	// neighboring cliques lowers modularity, so each should be found as is; this takes
+	// more than a single level of aggregation to get right in general.
+	g := cliques(6, 5)
+	for i := 0; i < 6; i++ {
+		g.AddEdges(gogl.NewEdge(i*5, (i*5+7)%30))
+	}
+
+	partition, q := Louvain(g)
+	checkPartition(c, g, partition, q)
+
+	var groups [][]gogl.Vertex
+	for i := 0; i < 6; i++ {
+		groups = append(groups, []gogl.Vertex{i * 5, i*5 + 1, i*5 + 2, i*5 + 3, i*5 + 4})
+	}
+	checkGroups(c, partition, groups...)
+}
+
+func (s *LouvainSuite) TestWeightedLouvain(c *C) {
+	// A square; the heavy edges hold their endpoints together.
+	g := gogl.Spec().Weighted().Using(gogl.WeightedEdgeList{
+		gogl.NewWeightedEdge("a", "b", 5),
+		gogl.NewWeightedEdge("b", "c", 1),
+		gogl.NewWeightedEdge("c", "d", 5),
+		gogl.NewWeightedEdge("d", "a", 1),
+	}).Create(al.G).(gogl.WeightedGraph)
+
+	partition, q, err := WeightedLouvain(g)
+	c.Assert(err, IsNil)
+	checkGroups(c, partition, []gogl.Vertex{"a", "b"}, []gogl.Vertex{"c", "d"})
+
+	expected, _ := WeightedModularity(g, partition)
+	c.Assert(q, Equals, expected)
+
+	_, _, err = WeightedLouvain(unweighted{g})
+	c.Assert(err, ErrorMatches, "Graph contains an edge that is not weighted.")
+}
+
+func (s *LouvainSuite) TestLouvainRandom(c *C) {
+	r := stdrand.New(stdrand.NewSource(11))
+	for iter := 0; iter < 20; iter++ {
+		g := randomGraph(r, 30, 0.15)
+
+		partition, q := Louvain(g)
+		checkPartition(c, g, partition, q)
+
+		// never worse than leaving every vertex alone, or grouping them all together
+		singletons := make(map[gogl.Vertex]int)
+		for v := range partition {
+			singletons[v] = v.(int)
+		}
+		alone, _ := Modularity(g, singletons)
+		c.Assert(q >= alone, Equals, true, Commentf("iteration %d", iter))
+		c.Assert(q >= 0, Equals, true, Commentf("iteration %d", iter))
+	}
+}
@@ -0,0 +1,186 @@
+package components
+
+import (
+	"github.com/sdboyer/gogl"
+	"github.com/sdboyer/gogl/dfs"
+)
+
+// Finds the articulation points (or cut vertices) of the provided graph: those
+// vertices whose removal would increase the number of connected components.
+//
+// Edge directionality is ignored. The vertices are not in any particular order.
+func ArticulationPoints(g gogl.Graph) []gogl.Vertex {
+	vis := biconnect(g)
+
+	points := make([]gogl.Vertex, 0, len(vis.articulation))
+	for v := range vis.articulation {
+		points = append(points, v)
+	}
+
+	return points
+}
+
+// Finds the bridges (or cut edges) of the provided graph: those edges whose removal
+// would increase the number of connected components.
+//
+// Edge directionality is ignored. The edges are returned as enumerated by the graph,
+// in no particular order. If a graph allows parallel edges, an edge with a parallel
+// twin is never a bridge.
+func Bridges(g gogl.Graph) gogl.EdgeList {
+	return biconnect(g).bridges
+}
+
+// Decomposes the edges of the provided graph into its biconnected components: maximal
+// sets of edges in which every pair of edges lies on a common simple cycle. A bridge
+// forms a component of its own.
+//
+// Every edge belongs to exactly one component, whereas articulation points are shared
+// by all the components they join. Vertex isolates have no edges, and so do not appear
+// in any component.
+//
+// Edge directionality is ignored. Neither the components nor their edges are in any
+// particular order.
+func BiconnectedComponents(g gogl.Graph) []gogl.EdgeList {
+	return biconnect(g).components
+}
+
+// Runs Tarjan's lowpoint algorithm over the whole graph.
+func biconnect(g gogl.Graph) *biconnectVisitor {
+	vis := &biconnectVisitor{
+		disc:         make(map[gogl.Vertex]int),
+		low:          make(map[gogl.Vertex]int),
+		parent:       make(map[gogl.Vertex]gogl.Vertex),
+		tree:         make(map[gogl.Vertex]gogl.Edge),
+		treeIndex:    make(map[gogl.Vertex]int),
+		skipped:      make(map[gogl.Vertex]bool),
+		children:     make(map[gogl.Vertex]int),
+		articulation: make(map[gogl.Vertex]bool),
+	}
+
+	vertices := gogl.CollectVertices(g)
+	if len(vertices) == 0 {
+		return vis
+	}
+
+	// Embedding only the Graph interface hides any Digraph methods, so the traversal
+	// follows incident edges in both directions.
+	dfs.Traverse(struct{ gogl.Graph }{g}, vis, vertices...)
+
+	return vis
+}
+
+// A dfs.Visitor that computes discovery times and lowpoints, and from them the
+// articulation points, bridges and biconnected components of a graph.
+//
+// The traversal reports each edge before descending through it; a descent is recognized
+// by the edge's far end being undiscovered, and is always followed immediately by an
+// OnStartVertex call for that vertex.
+type biconnectVisitor struct {
+	counter int
+	// The discovery time of each vertex.
+	disc map[gogl.Vertex]int
+	// The earliest discovery time reachable from each vertex's subtree via one back edge.
+	low map[gogl.Vertex]int
+	// The depth-first tree parent of each vertex; roots have none.
+	parent map[gogl.Vertex]gogl.Vertex
+	// The tree edge by which each vertex was discovered.
+	tree map[gogl.Vertex]gogl.Edge
+	// The position of each vertex's tree edge in the edge stack.
+	treeIndex map[gogl.Vertex]int
+	// Whether each vertex has passed over the edge back to its parent yet.
+	skipped map[gogl.Vertex]bool
+	// The number of tree children of each vertex; only tracked for roots.
+	children map[gogl.Vertex]int
+
+	path    []gogl.Vertex
+	edges   gogl.EdgeList
+	pending gogl.Edge
+
+	articulation map[gogl.Vertex]bool
+	bridges      gogl.EdgeList
+	components   []gogl.EdgeList
+}
+
+func (vis *biconnectVisitor) OnBackEdge(vertex gogl.Vertex) {}
+
+func (vis *biconnectVisitor) OnStartVertex(v gogl.Vertex) {
+	vis.disc[v] = vis.counter
+	vis.low[v] = vis.counter
+	vis.counter++
+
+	if len(vis.path) > 0 {
+		vis.parent[v] = vis.path[len(vis.path)-1]
+		vis.tree[v] = vis.pending
+		vis.treeIndex[v] = len(vis.edges)
+		vis.edges = append(vis.edges, vis.pending)
+	}
+
+	vis.path = append(vis.path, v)
+}
+
+func (vis *biconnectVisitor) OnExamineEdge(e gogl.Edge) {
+	u := vis.path[len(vis.path)-1]
+	w, _ := e.Both()
+	if w == u {
+		_, w = e.Both()
+	}
+
+	dw, discovered := vis.disc[w]
+	if !discovered {
+		// A tree edge; recorded when w starts.
+		vis.pending = e
+		return
+	}
+
+	if p, exists := vis.parent[u]; exists && p == w && !vis.skipped[u] {
+		// The tree edge back to the parent; skip it exactly once, so that any
+		// parallel edges to the parent still count as back edges.
+		vis.skipped[u] = true
+		return
+	}
+
+	// Back edges are recorded only from the descendant's end; seen from the
+	// ancestor's end, the edge has already been handled.
+	if dw < vis.disc[u] {
+		vis.edges = append(vis.edges, e)
+		if dw < vis.low[u] {
+			vis.low[u] = dw
+		}
+	}
+}
+
+func (vis *biconnectVisitor) OnFinishVertex(v gogl.Vertex) {
+	vis.path = vis.path[:len(vis.path)-1]
+
+	p, exists := vis.parent[v]
+	if !exists {
+		if vis.children[v] > 1 {
+			vis.articulation[v] = true
+		}
+		return
+	}
+
+	if vis.low[v] < vis.low[p] {
+		vis.low[p] = vis.low[v]
+	}
+
+	if _, hasParent := vis.parent[p]; !hasParent {
+		vis.children[p]++
+	} else if vis.low[v] >= vis.disc[p] {
+		vis.articulation[p] = true
+	}
+
+	if vis.low[v] > vis.disc[p] {
+		vis.bridges = append(vis.bridges, vis.tree[v])
+	}
+
+	// v's subtree cannot reach above p, so p separates it; everything stacked since
+	// the tree edge into v forms one component.
+	if vis.low[v] >= vis.disc[p] {
+		i := vis.treeIndex[v]
+		component := make(gogl.EdgeList, len(vis.edges)-i)
+		copy(component, vis.edges[i:])
+		vis.components = append(vis.components, component)
+		vis.edges = vis.edges[:i]
+	}
+}
@@ -0,0 +1,192 @@
+package components
+
+import (
+	"fmt"
+	stdrand "math/rand"
+	"sort"
+
+	. "github.com/sdboyer/gocheck"
+	"github.com/sdboyer/gogl"
+	"github.com/sdboyer/gogl/graph/al"
+)
+
+// Two triangles joined by a bridge, with a pendant edge; and separately, a square
+// with a pendant edge. There are four articulation points: c, d, f and k.
+var bccEdgeSet = gogl.EdgeList{
+	gogl.NewEdge("a", "b"),
+	gogl.NewEdge("b", "c"),
+	gogl.NewEdge("c", "a"),
+	gogl.NewEdge("c", "d"),
+	gogl.NewEdge("d", "e"),
+	gogl.NewEdge("e", "f"),
+	gogl.NewEdge("f", "d"),
+	gogl.NewEdge("f", "g"),
+	gogl.NewEdge("h", "i"),
+	gogl.NewEdge("i", "j"),
+	gogl.NewEdge("j", "k"),
+	gogl.NewEdge("k", "h"),
+	gogl.NewEdge("k", "l"),
+}
+
+// Renders an edge with its endpoints in sorted order.
+func edgeString(e gogl.Edge) string {
+	a, b := e.Both()
+	u, v := fmt.Sprint(a), fmt.Sprint(b)
+	if u > v {
+		u, v = v, u
+	}
+	return u + "-" + v
+}
+
+func normalizeEdges(el gogl.EdgeList) []string {
+	out := []string{}
+	for _, e := range el {
+		out = append(out, edgeString(e))
+	}
+	sort.Strings(out)
+	return out
+}
+
+func normalizeVertices(vertices []gogl.Vertex) []string {
+	out := []string{}
+	for _, v := range vertices {
+		out = append(out, fmt.Sprint(v))
+	}
+	sort.Strings(out)
+	return out
+}
+
+type BiconnectedSuite struct{}
+
+var _ = Suite(&BiconnectedSuite{})
+
+func (s *BiconnectedSuite) TestBiconnected(c *C) {
+	g := gogl.Spec().Mutable().Using(bccEdgeSet).Create(al.G).(gogl.MutableGraph)
+	g.EnsureVertex("isolate")
+
+	c.Assert(normalizeVertices(ArticulationPoints(g)), DeepEquals, []string{"c", "d", "f", "k"})
+	c.Assert(normalizeEdges(Bridges(g)), DeepEquals, []string{"c-d", "f-g", "k-l"})
+
+	var comps []string
+	for _, comp := range BiconnectedComponents(g) {
+		comps = append(comps, fmt.Sprint(normalizeEdges(comp)))
+	}
+	sort.Strings(comps)
+	c.Assert(comps, DeepEquals, []string{
+		"[a-b a-c b-c]",
+		"[c-d]",
+		"[d-e d-f e-f]",
+		"[f-g]",
+		"[h-i h-k i-j j-k]",
+		"[k-l]",
+	})
+
+	// directionality is ignored
+	var el gogl.ArcList
+	for _, e := range bccEdgeSet {
+		el = append(el, gogl.NewArc(e.Both()))
+	}
+	dg := gogl.Spec().Directed().Using(el).Create(al.G)
+	c.Assert(normalizeVertices(ArticulationPoints(dg)), DeepEquals, []string{"c", "d", "f", "k"})
+	c.Assert(normalizeEdges(Bridges(dg)), DeepEquals, []string{"c-d", "f-g", "k-l"})
+	c.Assert(BiconnectedComponents(dg), HasLen, 6)
+}
+
+func (s *BiconnectedSuite) TestBiconnectedEmpty(c *C) {
+	g := gogl.Spec().Create(al.G)
+	c.Assert(ArticulationPoints(g), HasLen, 0)
+	c.Assert(Bridges(g), HasLen, 0)
+	c.Assert(BiconnectedComponents(g), HasLen, 0)
+}
+
+// A GraphSource over a fixed set of edges, minus one vertex or one edge.
+type without struct {
+	vertices []gogl.Vertex
+	edges    gogl.EdgeList
+	vertex   gogl.Vertex
+	edge     int
+}
+
+func (s without) Vertices(f gogl.VertexStep) {
+	for _, v := range s.vertices {
+		if v != s.vertex && f(v) {
+			return
+		}
+	}
+}
+
+func (s without) Edges(f gogl.EdgeStep) {
+	for i, e := range s.edges {
+		u, v := e.Both()
+		if i != s.edge && u != s.vertex && v != s.vertex && f(e) {
+			return
+		}
+	}
+}
+
+func (s *BiconnectedSuite) TestBiconnectedRandom(c *C) {
+	r := stdrand.New(stdrand.NewSource(3))
+	for iter := 0; iter < 30; iter++ {
+		g := gogl.Spec().Mutable().Create(al.G).(gogl.MutableGraph)
+		for i := 0; i < 12; i++ {
+			g.EnsureVertex(i)
+			for j := 0; j < i; j++ {
+				if r.Float64() < 0.2 {
+					g.AddEdges(gogl.NewEdge(i, j))
+				}
+			}
+		}
+
+		vertices := gogl.CollectVertices(g)
+		var edges gogl.EdgeList
+		g.Edges(func(e gogl.Edge) (terminate bool) {
+			edges = append(edges, e)
+			return
+		})
+		base := len(ConnectedComponentsOf(g))
+
+		points := make(map[gogl.Vertex]bool)
+		for _, v := range ArticulationPoints(g) {
+			points[v] = true
+		}
+		for _, v := range vertices {
+			split := len(ConnectedComponentsOf(without{vertices, edges, v, -1})) > base
+			c.Assert(points[v], Equals, split, Commentf("iteration %d, vertex %v", iter, v))
+		}
+
+		bridges := make(map[string]bool)
+		for _, e := range Bridges(g) {
+			bridges[edgeString(e)] = true
+		}
+		for i, e := range edges {
+			split := len(ConnectedComponentsOf(without{vertices, edges, nil, i})) > base
+			c.Assert(bridges[edgeString(e)], Equals, split, Commentf("iteration %d, edge %v", iter, e))
+		}
+
+		// every edge is in exactly one component; single-edge components are bridges,
+		// and vertices shared between components are articulation points
+		seen := make(map[string]int)
+		shared := make(map[gogl.Vertex]int)
+		for _, comp := range BiconnectedComponents(g) {
+			if len(comp) == 1 {
+				c.Assert(bridges[edgeString(comp[0])], Equals, true)
+			}
+			members := make(map[gogl.Vertex]bool)
+			for _, e := range comp {
+				seen[edgeString(e)]++
+				u, v := e.Both()
+				members[u], members[v] = true, true
+			}
+			for v := range members {
+				shared[v]++
+			}
+		}
+		c.Assert(len(seen), Equals, len(edges))
+		for e, n := range seen {
+			c.Assert(n, Equals, 1, Commentf("edge %v", e))
+		}
+		for v, n := range shared {
+			c.Assert(points[v], Equals, n > 1, Commentf("vertex %v", v))
+		}
+	}
+}
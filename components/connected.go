@@ -0,0 +1,62 @@
+package components
+
+import (
+	"github.com/sdboyer/gogl"
+)
+
+// Decomposes the provided graph into its connected components: maximal sets of
+// vertices in which there is a path between every pair of vertices. Vertex
+// isolates form components of their own.
+//
+// Edge directionality is ignored, so for a digraph, this returns the weakly
+// connected components. Neither the components nor their members are in any
+// particular order.
+func ConnectedComponents(g gogl.Graph) [][]gogl.Vertex {
+	visited := make(map[gogl.Vertex]bool)
+	var components [][]gogl.Vertex
+
+	g.Vertices(func(root gogl.Vertex) (terminate bool) {
+		if visited[root] {
+			return
+		}
+
+		visited[root] = true
+		component := []gogl.Vertex{root}
+		for i := 0; i < len(component); i++ {
+			g.AdjacentTo(component[i], func(adj gogl.Vertex) (terminate bool) {
+				if !visited[adj] {
+					visited[adj] = true
+					component = append(component, adj)
+				}
+				return
+			})
+		}
+
+		components = append(components, component)
+		return
+	})
+
+	return components
+}
+
+// Decomposes the provided graph source into its connected components, in the same
+// manner as ConnectedComponents.
+//
+// Rather than traversing adjacencies, this streams the source's vertices and edges
+// once each into a DisjointSet. It can thus compute components directly from a
+// GraphSource, such as an EdgeList, without first creating a full graph from it.
+func ConnectedComponentsOf(src gogl.GraphSource) [][]gogl.Vertex {
+	ds := NewDisjointSet()
+
+	src.Vertices(func(v gogl.Vertex) (terminate bool) {
+		ds.Add(v)
+		return
+	})
+
+	src.Edges(func(e gogl.Edge) (terminate bool) {
+		ds.Union(e.Both())
+		return
+	})
+
+	return ds.Sets()
+}
@@ -0,0 +1,46 @@
+package components
+
+import (
+	. "github.com/sdboyer/gocheck"
+	"github.com/sdboyer/gogl"
+	"github.com/sdboyer/gogl/graph/al"
+)
+
+var ccEdgeSet = gogl.EdgeList{
+	gogl.NewEdge("a", "b"),
+	gogl.NewEdge("b", "c"),
+	gogl.NewEdge("d", "e"),
+	gogl.NewEdge("f", "g"),
+	gogl.NewEdge("g", "h"),
+	gogl.NewEdge("h", "f"),
+}
+
+type ConnectedComponentsSuite struct{}
+
+var _ = Suite(&ConnectedComponentsSuite{})
+
+var ccExpected = []string{"[a b c]", "[d e]", "[f g h]"}
+
+func (s *ConnectedComponentsSuite) TestConnectedComponents(c *C) {
+	g := gogl.Spec().Mutable().Using(ccEdgeSet).Create(al.G).(gogl.MutableGraph)
+	c.Assert(normalize(ConnectedComponents(g)), DeepEquals, ccExpected)
+
+	g.EnsureVertex("isolate")
+	c.Assert(normalize(ConnectedComponents(g)), DeepEquals, append(ccExpected, "[isolate]"))
+
+	// weak connectivity in digraphs
+	dg := gogl.Spec().Directed().Using(gogl.ArcList{
+		gogl.NewArc("a", "b"),
+		gogl.NewArc("c", "b"),
+		gogl.NewArc("d", "e"),
+	}).Create(al.G)
+	c.Assert(normalize(ConnectedComponents(dg)), DeepEquals, []string{"[a b c]", "[d e]"})
+}
+
+func (s *ConnectedComponentsSuite) TestConnectedComponentsOf(c *C) {
+	c.Assert(normalize(ConnectedComponentsOf(ccEdgeSet)), DeepEquals, ccExpected)
+
+	g := gogl.Spec().Mutable().Using(ccEdgeSet).Create(al.G).(gogl.MutableGraph)
+	g.EnsureVertex("isolate")
+	c.Assert(normalize(ConnectedComponentsOf(g)), DeepEquals, append(ccExpected, "[isolate]"))
+}
@@ -0,0 +1,138 @@
+package components
+
+import (
+	"github.com/sdboyer/gogl"
+)
+
+// A DisjointSet (or union-find) tracks a partition of vertices into disjoint sets,
+// and supports efficiently merging sets and determining which set a vertex is in.
+//
+// Each set is identified by a representative vertex, its root. Path compression
+// and union by rank keep the amortized cost of all operations near-constant.
+//
+// The zero value is not usable; create DisjointSets with NewDisjointSet().
+type DisjointSet struct {
+	parent map[gogl.Vertex]gogl.Vertex
+	rank   map[gogl.Vertex]int
+	count  int
+}
+
+// Creates a new DisjointSet, with each of the provided vertices in a set of its own.
+func NewDisjointSet(vertices ...gogl.Vertex) *DisjointSet {
+	ds := &DisjointSet{
+		parent: make(map[gogl.Vertex]gogl.Vertex, len(vertices)),
+		rank:   make(map[gogl.Vertex]int, len(vertices)),
+	}
+	ds.Add(vertices...)
+
+	return ds
+}
+
+// Adds the provided vertices, each in a set of its own. If a provided vertex is
+// already present, it is a no-op (for that vertex only).
+func (ds *DisjointSet) Add(vertices ...gogl.Vertex) {
+	for _, v := range vertices {
+		if _, exists := ds.parent[v]; !exists {
+			ds.parent[v] = v
+			ds.count++
+		}
+	}
+}
+
+// Indicates whether or not the given vertex is present in any set.
+func (ds *DisjointSet) Has(v gogl.Vertex) bool {
+	_, exists := ds.parent[v]
+	return exists
+}
+
+// Returns the root of the set containing the given vertex. If the vertex is not
+// present, the second return value will be false.
+func (ds *DisjointSet) Find(v gogl.Vertex) (root gogl.Vertex, exists bool) {
+	if _, exists = ds.parent[v]; !exists {
+		return nil, false
+	}
+
+	return ds.find(v), true
+}
+
+func (ds *DisjointSet) find(v gogl.Vertex) gogl.Vertex {
+	root := v
+	for ds.parent[root] != root {
+		root = ds.parent[root]
+	}
+
+	// compress the path, so later finds go straight to the root
+	for v != root {
+		next := ds.parent[v]
+		ds.parent[v] = root
+		v = next
+	}
+
+	return root
+}
+
+// Merges the sets containing the two given vertices. Vertices that are not yet
+// present are added first.
+//
+// Returns true if the vertices were previously in different sets, and false if
+// they were already in the same set.
+func (ds *DisjointSet) Union(u, v gogl.Vertex) (merged bool) {
+	ds.Add(u, v)
+
+	ru, rv := ds.find(u), ds.find(v)
+	if ru == rv {
+		return false
+	}
+
+	switch {
+	case ds.rank[ru] < ds.rank[rv]:
+		ds.parent[ru] = rv
+	case ds.rank[ru] > ds.rank[rv]:
+		ds.parent[rv] = ru
+	default:
+		ds.parent[rv] = ru
+		ds.rank[ru]++
+	}
+
+	ds.count--
+	return true
+}
+
+// Indicates whether the two given vertices are present and in the same set.
+func (ds *DisjointSet) Connected(u, v gogl.Vertex) bool {
+	if !ds.Has(u) || !ds.Has(v) {
+		return false
+	}
+
+	return ds.find(u) == ds.find(v)
+}
+
+// Returns the number of disjoint sets.
+func (ds *DisjointSet) Count() int {
+	return ds.count
+}
+
+// Returns the number of vertices across all sets.
+func (ds *DisjointSet) Order() int {
+	return len(ds.parent)
+}
+
+// Collects the disjoint sets into a slice of vertex slices, for easy range-ing.
+// Neither the sets nor their members are in any particular order.
+func (ds *DisjointSet) Sets() [][]gogl.Vertex {
+	index := make(map[gogl.Vertex]int, ds.count)
+	sets := make([][]gogl.Vertex, 0, ds.count)
+
+	for v := range ds.parent {
+		root := ds.find(v)
+		i, exists := index[root]
+		if !exists {
+			i = len(sets)
+			index[root] = i
+			sets = append(sets, nil)
+		}
+		sets[i] = append(sets[i], v)
+	}
+
+	return sets
+}
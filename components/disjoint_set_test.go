@@ -0,0 +1,59 @@
+package components
+
+import (
+	. "github.com/sdboyer/gocheck"
+	"github.com/sdboyer/gogl"
+)
+
+type DisjointSetSuite struct{}
+
+var _ = Suite(&DisjointSetSuite{})
+
+func (s *DisjointSetSuite) TestAddFind(c *C) {
+	ds := NewDisjointSet("foo", "bar")
+	c.Assert(ds.Count(), Equals, 2)
+	c.Assert(ds.Order(), Equals, 2)
+
+	ds.Add("foo", "baz")
+	c.Assert(ds.Count(), Equals, 3)
+	c.Assert(ds.Has("baz"), Equals, true)
+	c.Assert(ds.Has("qux"), Equals, false)
+
+	root, exists := ds.Find("foo")
+	c.Assert(exists, Equals, true)
+	c.Assert(root, Equals, "foo")
+
+	root, exists = ds.Find("qux")
+	c.Assert(exists, Equals, false)
+	c.Assert(root, IsNil)
+}
+
+func (s *DisjointSetSuite) TestUnion(c *C) {
+	ds := NewDisjointSet()
+
+	c.Assert(ds.Union(1, 2), Equals, true)
+	c.Assert(ds.Union(3, 4), Equals, true)
+	c.Assert(ds.Count(), Equals, 2)
+	c.Assert(ds.Connected(1, 2), Equals, true)
+	c.Assert(ds.Connected(1, 3), Equals, false)
+	c.Assert(ds.Connected(1, 5), Equals, false)
+
+	c.Assert(ds.Union(2, 4), Equals, true)
+	c.Assert(ds.Union(1, 3), Equals, false)
+	c.Assert(ds.Count(), Equals, 1)
+	c.Assert(ds.Connected(1, 4), Equals, true)
+
+	r1, _ := ds.Find(1)
+	r4, _ := ds.Find(4)
+	c.Assert(r1, Equals, r4)
+}
+
+func (s *DisjointSetSuite) TestSets(c *C) {
+	ds := NewDisjointSet("a", "b", "c", "d", "e")
+	ds.Union("a", "b")
+	ds.Union("c", "d")
+	ds.Union("b", "d")
+
+	c.Assert(normalize(ds.Sets()), DeepEquals, []string{"[a b c d]", "[e]"})
+	c.Assert(NewDisjointSet().Sets(), DeepEquals, [][]gogl.Vertex{})
+}
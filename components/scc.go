@@ -0,0 +1,202 @@
+// Contains algos and logic for decomposing graphs into their components.
+package components
+
+import (
+	"github.com/sdboyer/gogl"
+	"github.com/sdboyer/gogl/dfs"
+)
+
+// Decomposes the provided digraph into its strongly connected components using
+// Tarjan's algorithm.
+//
+// Each component is a set of vertices in which every vertex is reachable from
+// every other vertex; every vertex in the graph belongs to exactly one component.
+// Components are returned in reverse topological order: no component has an arc
+// to any component that appears after it in the returned slice.
+func Tarjan(g gogl.Digraph) [][]gogl.Vertex {
+	t := &tarjan{
+		g:       g,
+		index:   make(map[gogl.Vertex]int),
+		lowlink: make(map[gogl.Vertex]int),
+		onstack: make(map[gogl.Vertex]bool),
+	}
+
+	g.Vertices(func(v gogl.Vertex) (terminate bool) {
+		if _, visited := t.index[v]; !visited {
+			t.strongconnect(v)
+		}
+		return
+	})
+
+	return t.components
+}
+
+type tarjan struct {
+	g          gogl.Digraph
+	counter    int
+	index      map[gogl.Vertex]int
+	lowlink    map[gogl.Vertex]int
+	onstack    map[gogl.Vertex]bool
+	stack      []gogl.Vertex
+	components [][]gogl.Vertex
+}
+
+func (t *tarjan) strongconnect(v gogl.Vertex) {
+	t.index[v] = t.counter
+	t.lowlink[v] = t.counter
+	t.counter++
+	t.stack = append(t.stack, v)
+	t.onstack[v] = true
+
+	t.g.SuccessorsOf(v, func(w gogl.Vertex) (terminate bool) {
+		if _, visited := t.index[w]; !visited {
+			t.strongconnect(w)
+			if t.lowlink[w] < t.lowlink[v] {
+				t.lowlink[v] = t.lowlink[w]
+			}
+		} else if t.onstack[w] && t.index[w] < t.lowlink[v] {
+			t.lowlink[v] = t.index[w]
+		}
+		return
+	})
+
+	// v is the root of a component; pop the component off the stack
+	if t.lowlink[v] == t.index[v] {
+		var component []gogl.Vertex
+		for {
+			w := t.stack[len(t.stack)-1]
+			t.stack = t.stack[:len(t.stack)-1]
+			t.onstack[w] = false
+			component = append(component, w)
+			if w == v {
+				break
+			}
+		}
+		t.components = append(t.components, component)
+	}
+}
+
+// Decomposes the provided digraph into its strongly connected components using
+// Kosaraju's algorithm.
+//
+// Kosaraju's algorithm performs two depth-first passes: one over the graph to
+// establish the order in which vertices finish, then one over the graph's transpose,
+// in reverse finishing order, to collect the components. Components are returned
+// in topological order: no component has an arc to any component that appears
+// before it in the returned slice.
+//
+// Tarjan performs only a single pass, and does not require a transpose; it should
+// generally be preferred.
+func Kosaraju(g gogl.Digraph) [][]gogl.Vertex {
+	vertices := gogl.CollectVertices(g)
+	if len(vertices) == 0 {
+		return nil
+	}
+
+	// Using every vertex as a start point guarantees the whole graph is covered.
+	vis := &finishVisitor{}
+	dfs.Traverse(g, vis, vertices...)
+
+	t := g.Transpose()
+	assigned := make(map[gogl.Vertex]bool, len(vertices))
+	var components [][]gogl.Vertex
+
+	for i := len(vis.order) - 1; i >= 0; i-- {
+		root := vis.order[i]
+		if assigned[root] {
+			continue
+		}
+
+		assigned[root] = true
+		component := []gogl.Vertex{root}
+		for j := 0; j < len(component); j++ {
+			t.SuccessorsOf(component[j], func(w gogl.Vertex) (terminate bool) {
+				if !assigned[w] {
+					assigned[w] = true
+					component = append(component, w)
+				}
+				return
+			})
+		}
+		components = append(components, component)
+	}
+
+	return components
+}
+
+// Records the order in which a depth-first traversal finishes vertices.
+type finishVisitor struct {
+	order []gogl.Vertex
+}
+
+func (vis *finishVisitor) OnBackEdge(vertex gogl.Vertex) {}
+
+func (vis *finishVisitor) OnStartVertex(vertex gogl.Vertex) {}
+
+func (vis *finishVisitor) OnExamineEdge(edge gogl.Edge) {}
+
+func (vis *finishVisitor) OnFinishVertex(vertex gogl.Vertex) {
+	vis.order = append(vis.order, vertex)
+}
+
+// Builds the condensation of the provided digraph: the DAG formed by contracting
+// each of its strongly connected components into a single vertex.
+//
+// The strongly connected components are returned alongside the condensation. Each
+// vertex in the condensation is an int, indexing into the returned component slice;
+// an arc exists from component i to component j iff the original graph has an arc
+// from a vertex in i to a vertex in j. Components are as produced by Tarjan, and are
+// thus in reverse topological order.
+//
+// The condensation is created from the provided GraphSpec using the provided creator
+// function; the spec is forced to be directed, and its source is replaced.
+func Condensation(g gogl.Digraph, gs gogl.GraphSpec, f func(gogl.GraphSpec) gogl.Graph) (gogl.Digraph, [][]gogl.Vertex) {
+	components := Tarjan(g)
+
+	membership := make(map[gogl.Vertex]int)
+	for i, component := range components {
+		for _, v := range component {
+			membership[v] = i
+		}
+	}
+
+	src := &condensationSource{order: len(components)}
+	seen := make(map[[2]int]bool)
+	g.Arcs(func(a gogl.Arc) (terminate bool) {
+		ci, cj := membership[a.Source()], membership[a.Target()]
+		if ci != cj && !seen[[2]int{ci, cj}] {
+			seen[[2]int{ci, cj}] = true
+			src.arcs = append(src.arcs, gogl.NewArc(ci, cj))
+		}
+		return
+	})
+
+	return gs.Directed().Using(src).Create(f).(gogl.Digraph), components
+}
+
+// A DigraphSource describing a condensation. Unlike an ArcList, it can represent
+// components with no arcs to or from other components.
+type condensationSource struct {
+	order int
+	arcs  gogl.ArcList
+}
+
+func (s *condensationSource) Vertices(f gogl.VertexStep) {
+	for i := 0; i < s.order; i++ {
+		if f(i) {
+			return
+		}
+	}
+}
+
+func (s *condensationSource) Edges(f gogl.EdgeStep) {
+	s.arcs.Edges(f)
+}
+
+func (s *condensationSource) Arcs(f gogl.ArcStep) {
+	s.arcs.Arcs(f)
+}
+
+func (s *condensationSource) Order() int {
+	return s.order
+}
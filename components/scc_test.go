@@ -0,0 +1,111 @@
+package components
+
+import (
+	"fmt"
+	"sort"
+	"testing"
+
+	. "github.com/sdboyer/gocheck"
+	"github.com/sdboyer/gogl"
+	"github.com/sdboyer/gogl/graph/al"
+)
+
+// Hook gocheck into the go test runner
+func Test(t *testing.T) { TestingT(t) }
+
+// Three components, {a b c}, {d e} and {f}, plus an isolate {g}; a-b-c feed d-e, which feeds f.
+var sccArcSet = gogl.ArcList{
+	gogl.NewArc("a", "b"),
+	gogl.NewArc("b", "c"),
+	gogl.NewArc("c", "a"),
+	gogl.NewArc("c", "d"),
+	gogl.NewArc("d", "e"),
+	gogl.NewArc("e", "d"),
+	gogl.NewArc("e", "f"),
+	gogl.NewArc("b", "f"),
+}
+
+func sccGraph() gogl.Digraph {
+	g := gogl.Spec().Directed().Mutable().Using(sccArcSet).Create(al.G).(gogl.MutableDigraph)
+	g.EnsureVertex("g")
+	return g.(gogl.Digraph)
+}
+
+// Normalizes a component list into sorted strings, for order-insensitive comparison.
+func normalize(components [][]gogl.Vertex) []string {
+	var out []string
+	for _, comp := range components {
+		var s []string
+		for _, v := range comp {
+			s = append(s, fmt.Sprint(v))
+		}
+		sort.Strings(s)
+		out = append(out, fmt.Sprint(s))
+	}
+	sort.Strings(out)
+	return out
+}
+
+// Maps each vertex to the index of its component.
+func membership(components [][]gogl.Vertex) map[gogl.Vertex]int {
+	m := make(map[gogl.Vertex]int)
+	for i, comp := range components {
+		for _, v := range comp {
+			m[v] = i
+		}
+	}
+	return m
+}
+
+type SCCSuite struct{}
+
+var _ = Suite(&SCCSuite{})
+
+var sccExpected = []string{"[a b c]", "[d e]", "[f]", "[g]"}
+
+func (s *SCCSuite) TestTarjan(c *C) {
+	g := sccGraph()
+	components := Tarjan(g)
+	c.Assert(normalize(components), DeepEquals, sccExpected)
+
+	// reverse topological: arcs only point to earlier components
+	m := membership(components)
+	g.Arcs(func(a gogl.Arc) (terminate bool) {
+		c.Assert(m[a.Source()] >= m[a.Target()], Equals, true)
+		return
+	})
+}
+
+func (s *SCCSuite) TestKosaraju(c *C) {
+	g := sccGraph()
+	components := Kosaraju(g)
+	c.Assert(normalize(components), DeepEquals, sccExpected)
+
+	// topological: arcs only point to later components
+	m := membership(components)
+	g.Arcs(func(a gogl.Arc) (terminate bool) {
+		c.Assert(m[a.Source()] <= m[a.Target()], Equals, true)
+		return
+	})
+
+	empty := gogl.Spec().Directed().Create(al.G).(gogl.Digraph)
+	c.Assert(Kosaraju(empty), IsNil)
+	c.Assert(Tarjan(empty), IsNil)
+}
+
+func (s *SCCSuite) TestCondensation(c *C) {
+	g := sccGraph()
+	dag, components := Condensation(g, gogl.Spec().Immutable(), al.G)
+
+	c.Assert(gogl.Order(dag), Equals, 4)
+	c.Assert(gogl.Size(dag), Equals, 3)
+
+	m := membership(components)
+	c.Assert(dag.HasArc(gogl.NewArc(m["a"], m["d"])), Equals, true)
+	c.Assert(dag.HasArc(gogl.NewArc(m["a"], m["f"])), Equals, true)
+	c.Assert(dag.HasArc(gogl.NewArc(m["d"], m["f"])), Equals, true)
+	c.Assert(dag.HasVertex(m["g"]), Equals, true)
+
+	// the condensation of a condensation is itself
+	c.Assert(len(Tarjan(dag)), Equals, 4)
+}
@@ -117,12 +117,8 @@ func Toposort(g gogl.Graph, start ...gogl.Vertex) ([]gogl.Vertex, error) {
 		traverser = (*walker).dfutraverse
 	}
 
-	for v := stack.pop(); ; {
-		traverser(w, v)
-
-		if stack.length() == 0 {
-			break
-		}
+	for stack.length() > 0 {
+		traverser(w, stack.pop())
 	}
 
 	return visitor.GetTsl()
@@ -130,6 +126,9 @@ func Toposort(g gogl.Graph, start ...gogl.Vertex) ([]gogl.Vertex, error) {
 
 // Traverses the given graph in a depth-first manner, using the given visitor
 // and starting from the given vertices.
+//
+// Digraphs are traversed by following arcs; other graphs are traversed by following
+// incident edges in either direction.
 func Traverse(g gogl.Graph, visitor Visitor, start ...gogl.Vertex) (Visitor, error) {
 	start, err := buildStartQueue(g, start...)
 	if err != nil {
@@ -147,16 +146,16 @@ func Traverse(g gogl.Graph, visitor Visitor, start ...gogl.Vertex) (Visitor, err
 		colors: make(map[gogl.Vertex]uint),
 	}
 
+	var traverser func(*walker, gogl.Vertex)
 	if dg, ok := g.(gogl.Digraph); ok {
 		w.dg = dg
+		traverser = (*walker).dftraverse
+	} else {
+		traverser = (*walker).dfutraverse
 	}
 
-	for v := stack.pop(); ; {
-		w.dftraverse(v)
-
-		if stack.length() == 0 {
-			break
-		}
+	for stack.length() > 0 {
+		traverser(w, stack.pop())
 	}
 
 	return visitor, nil
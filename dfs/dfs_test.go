@@ -107,6 +107,43 @@ func (s *DepthFirstSearchSuite) TestToposort(c *C) {
 	c.Assert(tsl, DeepEquals, []gogl.Vertex{"qux", "baz", "bar", "foo"})
 }
 
+func (s *DepthFirstSearchSuite) TestTraverseMultipleStarts(c *C) {
+	el := gogl.ArcList{
+		gogl.NewArc("foo", "bar"),
+		gogl.NewArc("baz", "qux"),
+		gogl.NewArc("quark", "bar"),
+	}
+	g := gogl.Spec().Directed().Using(el).
+		Create(al.G).(gogl.Digraph)
+
+	vis := &TslVisitor{}
+	_, err := Traverse(g, vis, "foo", "baz", "quark")
+	c.Assert(err, IsNil)
+
+	tsl, err := vis.GetTsl()
+	c.Assert(err, IsNil)
+	c.Assert(len(tsl), Equals, 5)
+}
+
+func (s *DepthFirstSearchSuite) TestTraverseUndirected(c *C) {
+	el := gogl.EdgeList{
+		gogl.NewEdge("foo", "bar"),
+		gogl.NewEdge("bar", "baz"),
+		gogl.NewEdge("qux", "baz"),
+	}
+	g := gogl.Spec().Using(el).Create(al.G)
+
+	_, err := Traverse(g, &TslVisitor{})
+	c.Assert(err, ErrorMatches, ".*do not have sources.*")
+
+	vis := &TslVisitor{}
+	_, err = Traverse(g, vis, "qux")
+	c.Assert(err, IsNil)
+
+	tsl, _ := vis.GetTsl()
+	c.Assert(tsl, DeepEquals, []gogl.Vertex{"foo", "bar", "baz", "qux"})
+}
+
 // This is a bit wackyhacky, but works well enough
 var _ = Suite(&TestVisitor{})
 
@@ -0,0 +1,74 @@
+package flow
+
+import (
+	"math"
+)
+
+// Calculates a maximum flow from s to t using Dinic's algorithm.
+func (n *network) dinic(s, t int) (value float64) {
+	level := make([]int, len(n.vertices))
+	iter := make([]int, len(n.vertices))
+
+	for n.levelGraph(s, t, level) {
+		for i := range iter {
+			iter[i] = 0
+		}
+
+		for {
+			f := n.blockingFlow(s, t, math.Inf(1), level, iter)
+			if f == 0 {
+				break
+			}
+			value += f
+		}
+	}
+
+	return value
+}
+
+// Assigns each vertex its breadth-first distance from s in the residual network,
+// or -1 if unreachable. Reports whether t was reached.
+func (n *network) levelGraph(s, t int, level []int) bool {
+	for i := range level {
+		level[i] = -1
+	}
+	level[s] = 0
+
+	queue := []int{s}
+	for len(queue) > 0 {
+		u := queue[0]
+		queue = queue[1:]
+		for _, e := range n.adj[u] {
+			if v := n.to[e]; n.cap[e] > 0 && level[v] == -1 {
+				level[v] = level[u] + 1
+				queue = append(queue, v)
+			}
+		}
+	}
+
+	return level[t] != -1
+}
+
+// Finds a single augmenting path through the level graph by depth-first search,
+// and pushes as much flow along it as possible.
+//
+// Each vertex's position in its edge list is retained across calls in iter, so that
+// edges found to be dead ends are never examined again within the same phase.
+func (n *network) blockingFlow(u, t int, limit float64, level, iter []int) float64 {
+	if u == t {
+		return limit
+	}
+
+	for ; iter[u] < len(n.adj[u]); iter[u]++ {
+		e := n.adj[u][iter[u]]
+		v := n.to[e]
+		if n.cap[e] > 0 && level[v] == level[u]+1 {
+			if f := n.blockingFlow(v, t, math.Min(limit, n.cap[e]), level, iter); f > 0 {
+				n.augment(e, f)
+				return f
+			}
+		}
+	}
+
+	return 0
+}
@@ -0,0 +1,43 @@
+package flow
+
+import (
+	"math"
+)
+
+// Calculates a maximum flow from s to t using the Edmonds-Karp algorithm.
+func (n *network) edmondsKarp(s, t int) (value float64) {
+	parent := make([]int, len(n.vertices)) // edge used to reach each vertex
+
+	for {
+		for i := range parent {
+			parent[i] = -1
+		}
+
+		// breadth-first search for the shortest augmenting path
+		queue := []int{s}
+		for len(queue) > 0 && parent[t] == -1 {
+			u := queue[0]
+			queue = queue[1:]
+			for _, e := range n.adj[u] {
+				if v := n.to[e]; n.cap[e] > 0 && v != s && parent[v] == -1 {
+					parent[v] = e
+					queue = append(queue, v)
+				}
+			}
+		}
+
+		if parent[t] == -1 {
+			return value
+		}
+
+		bottleneck := math.Inf(1)
+		for v := t; v != s; v = n.to[parent[v]^1] {
+			bottleneck = math.Min(bottleneck, n.cap[parent[v]])
+		}
+		for v := t; v != s; v = n.to[parent[v]^1] {
+			n.augment(parent[v], bottleneck)
+		}
+
+		value += bottleneck
+	}
+}
@@ -0,0 +1,183 @@
+// Contains algos and logic related to network flows.
+//
+// A flow network is represented by a WeightedDigraph, where the weight of each
+// arc is its capacity. Capacities must be non-negative.
+package flow
+
+import (
+	"errors"
+
+	"github.com/sdboyer/gogl"
+)
+
+// An Algorithm identifies a strategy for calculating a maximum flow.
+type Algorithm int
+
+const (
+	// Edmonds-Karp repeatedly augments along shortest paths, found by breadth-first
+	// search. It runs in O(VE^2) time, and is simple and predictable.
+	EdmondsKarp Algorithm = iota
+	// Dinic augments along all shortest paths at once by finding blocking flows in
+	// a layered graph. It runs in O(V^2 E) time, and is particularly fast on unit
+	// capacity networks, such as those arising from bipartite matching.
+	Dinic
+	// PushRelabel maintains a preflow, pushing excess toward the sink along
+	// admissible arcs and relabeling vertices as necessary. This FIFO variant runs
+	// in O(V^3) time, and tends to do well on dense networks.
+	PushRelabel
+)
+
+// A Result describes a maximum flow, and a corresponding minimum cut.
+type Result struct {
+	// The total value of the flow; equivalently, the capacity of the minimum cut.
+	Value float64
+	// Every arc in the network that carries a positive flow, weighted by that flow.
+	Flow gogl.WeightedArcList
+	// The vertices on the source side of the minimum cut: those still reachable from
+	// the source in the residual network.
+	SourceSide []gogl.Vertex
+	// The remaining vertices, on the sink side of the minimum cut.
+	SinkSide []gogl.Vertex
+}
+
+// Calculates a maximum flow from the given source vertex to the given sink vertex
+// in the provided network, using the given algorithm.
+//
+// All arcs must implement WeightedArc, with non-negative weights; otherwise, an
+// error is returned.
+func MaxFlow(g gogl.WeightedDigraph, source, sink gogl.Vertex, algo Algorithm) (*Result, error) {
+	if !g.HasVertex(source) {
+		return nil, errors.New("Source vertex is not present in graph.")
+	}
+	if !g.HasVertex(sink) {
+		return nil, errors.New("Sink vertex is not present in graph.")
+	}
+	if source == sink {
+		return nil, errors.New("Source and sink vertices must be distinct.")
+	}
+
+	n, err := newNetwork(g)
+	if err != nil {
+		return nil, err
+	}
+
+	s, t := n.index[source], n.index[sink]
+	var value float64
+
+	switch algo {
+	case EdmondsKarp:
+		value = n.edmondsKarp(s, t)
+	case Dinic:
+		value = n.dinic(s, t)
+	case PushRelabel:
+		value = n.pushRelabel(s, t)
+	default:
+		return nil, errors.New("Unrecognized max flow algorithm.")
+	}
+
+	return n.result(s, value), nil
+}
+
+// A residual network over dense vertex indices.
+//
+// Each arc in the original graph is represented by a pair of residual edges: a
+// forward edge, at an even id, and its reverse, at the following odd id. Thus,
+// the partner of edge e is always e^1.
+type network struct {
+	vertices []gogl.Vertex
+	index    map[gogl.Vertex]int
+	adj      [][]int   // edge ids leaving each vertex
+	to       []int     // target of each edge
+	cap      []float64 // residual capacity of each edge
+	orig     []float64 // original capacity of each forward edge, indexed by e/2
+}
+
+func newNetwork(g gogl.WeightedDigraph) (n *network, err error) {
+	order := gogl.Order(g)
+	n = &network{
+		vertices: make([]gogl.Vertex, 0, order),
+		index:    make(map[gogl.Vertex]int, order),
+		adj:      make([][]int, order),
+	}
+
+	g.Vertices(func(v gogl.Vertex) (terminate bool) {
+		n.index[v] = len(n.vertices)
+		n.vertices = append(n.vertices, v)
+		return
+	})
+
+	g.Arcs(func(a gogl.Arc) (terminate bool) {
+		wa, ok := a.(gogl.WeightedArc)
+		if !ok {
+			err = errors.New("Graph contains an arc that is not weighted.")
+			return true
+		}
+		if wa.Weight() < 0 {
+			err = errors.New("Graph contains an arc with negative capacity.")
+			return true
+		}
+
+		u, v := n.index[a.Source()], n.index[a.Target()]
+		e := len(n.to)
+		n.to = append(n.to, v, u)
+		n.cap = append(n.cap, wa.Weight(), 0)
+		n.orig = append(n.orig, wa.Weight())
+		n.adj[u] = append(n.adj[u], e)
+		n.adj[v] = append(n.adj[v], e+1)
+		return
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return n, nil
+}
+
+// Pushes the given amount of flow along residual edge e.
+func (n *network) augment(e int, amount float64) {
+	n.cap[e] -= amount
+	n.cap[e^1] += amount
+}
+
+// Assembles a Result from the network's final residual state.
+func (n *network) result(s int, value float64) *Result {
+	r := &Result{Value: value}
+
+	for e := 0; e < len(n.to); e += 2 {
+		if f := n.orig[e/2] - n.cap[e]; f > 0 {
+			r.Flow = append(r.Flow, gogl.NewWeightedArc(n.vertices[n.to[e^1]], n.vertices[n.to[e]], f))
+		}
+	}
+
+	reachable := n.residualReach(s)
+	for i, v := range n.vertices {
+		if reachable[i] {
+			r.SourceSide = append(r.SourceSide, v)
+		} else {
+			r.SinkSide = append(r.SinkSide, v)
+		}
+	}
+
+	return r
+}
+
+// Marks all vertices reachable from s along edges with remaining residual capacity.
+func (n *network) residualReach(s int) []bool {
+	reachable := make([]bool, len(n.vertices))
+	reachable[s] = true
+
+	queue := []int{s}
+	for len(queue) > 0 {
+		u := queue[0]
+		queue = queue[1:]
+		for _, e := range n.adj[u] {
+			if v := n.to[e]; n.cap[e] > 0 && !reachable[v] {
+				reachable[v] = true
+				queue = append(queue, v)
+			}
+		}
+	}
+
+	return reachable
+}
@@ -0,0 +1,159 @@
+package flow
+
+import (
+	"math/rand"
+	"testing"
+
+	. "github.com/sdboyer/gocheck"
+	"github.com/sdboyer/gogl"
+	"github.com/sdboyer/gogl/graph/al"
+)
+
+// Hook gocheck into the go test runner
+func Test(t *testing.T) { TestingT(t) }
+
+// The CLRS flow network, with a max flow of 23 from s to t.
+var flowArcSet = gogl.WeightedArcList{
+	gogl.NewWeightedArc("s", "v1", 16),
+	gogl.NewWeightedArc("s", "v2", 13),
+	gogl.NewWeightedArc("v2", "v1", 4),
+	gogl.NewWeightedArc("v1", "v3", 12),
+	gogl.NewWeightedArc("v3", "v2", 9),
+	gogl.NewWeightedArc("v2", "v4", 14),
+	gogl.NewWeightedArc("v4", "v3", 7),
+	gogl.NewWeightedArc("v3", "t", 20),
+	gogl.NewWeightedArc("v4", "t", 4),
+}
+
+var algorithms = map[string]Algorithm{
+	"EdmondsKarp": EdmondsKarp,
+	"Dinic":       Dinic,
+	"PushRelabel": PushRelabel,
+}
+
+type MaxFlowSuite struct{}
+
+var _ = Suite(&MaxFlowSuite{})
+
+// Verifies that a result describes a valid flow, and that its cut matches its value.
+func checkResult(c *C, g gogl.WeightedDigraph, source, sink gogl.Vertex, r *Result) {
+	net := make(map[gogl.Vertex]float64)
+	for _, a := range r.Flow {
+		wa := a.(gogl.WeightedArc)
+		c.Assert(g.HasArc(wa), Equals, true)
+
+		var capacity float64
+		g.ArcsFrom(wa.Source(), func(ga gogl.Arc) (terminate bool) {
+			if ga.Target() == wa.Target() {
+				capacity = ga.(gogl.WeightedArc).Weight()
+			}
+			return
+		})
+		c.Assert(wa.Weight() <= capacity, Equals, true)
+
+		net[wa.Source()] -= wa.Weight()
+		net[wa.Target()] += wa.Weight()
+	}
+
+	// conservation everywhere but the terminals
+	for v, f := range net {
+		if v != source && v != sink {
+			c.Assert(f, Equals, float64(0))
+		}
+	}
+	c.Assert(net[sink], Equals, r.Value)
+
+	c.Assert(len(r.SourceSide)+len(r.SinkSide), Equals, gogl.Order(g))
+	c.Assert(r.SourceSide, Contains, source)
+	c.Assert(r.SinkSide, Contains, sink)
+
+	// capacity across the cut equals the flow value
+	side := make(map[gogl.Vertex]bool)
+	for _, v := range r.SourceSide {
+		side[v] = true
+	}
+	var cut float64
+	g.Arcs(func(a gogl.Arc) (terminate bool) {
+		if side[a.Source()] && !side[a.Target()] {
+			cut += a.(gogl.WeightedArc).Weight()
+		}
+		return
+	})
+	c.Assert(cut, Equals, r.Value)
+}
+
+func (s *MaxFlowSuite) TestMaxFlow(c *C) {
+	g := gogl.Spec().Directed().Weighted().Using(flowArcSet).Create(al.G).(gogl.WeightedDigraph)
+
+	for name, algo := range algorithms {
+		r, err := MaxFlow(g, "s", "t", algo)
+		c.Assert(err, IsNil)
+		c.Assert(r.Value, Equals, float64(23), Commentf(name))
+		checkResult(c, g, "s", "t", r)
+	}
+}
+
+func (s *MaxFlowSuite) TestDisconnected(c *C) {
+	g := gogl.Spec().Directed().Weighted().Using(gogl.WeightedArcList{
+		gogl.NewWeightedArc(1, 2, 5),
+		gogl.NewWeightedArc(3, 4, 5),
+	}).Create(al.G).(gogl.WeightedDigraph)
+
+	for _, algo := range algorithms {
+		r, err := MaxFlow(g, 1, 4, algo)
+		c.Assert(err, IsNil)
+		c.Assert(r.Value, Equals, float64(0))
+		c.Assert(len(r.Flow), Equals, 0)
+		c.Assert(len(r.SourceSide), Equals, 2)
+	}
+}
+
+func (s *MaxFlowSuite) TestRandomAgreement(c *C) {
+	r := rand.New(rand.NewSource(42))
+
+	for round := 0; round < 20; round++ {
+		var el gogl.WeightedArcList
+		for u := 0; u < 12; u++ {
+			for v := 0; v < 12; v++ {
+				if u != v && r.Intn(4) == 0 {
+					el = append(el, gogl.NewWeightedArc(u, v, float64(r.Intn(20))))
+				}
+			}
+		}
+
+		g := gogl.Spec().Directed().Weighted().Mutable().Using(el).Create(al.G)
+		g.(gogl.VertexSetMutator).EnsureVertex(0, 11)
+		dg := g.(gogl.WeightedDigraph)
+
+		values := make(map[string]float64)
+		for name, algo := range algorithms {
+			res, err := MaxFlow(dg, 0, 11, algo)
+			c.Assert(err, IsNil)
+			checkResult(c, dg, 0, 11, res)
+			values[name] = res.Value
+		}
+
+		for name, v := range values {
+			c.Assert(v, Equals, values["EdmondsKarp"], Commentf(name))
+		}
+	}
+}
+
+func (s *MaxFlowSuite) TestErrors(c *C) {
+	g := gogl.Spec().Directed().Weighted().Using(flowArcSet).Create(al.G).(gogl.WeightedDigraph)
+
+	_, err := MaxFlow(g, "nope", "t", Dinic)
+	c.Assert(err, ErrorMatches, "Source vertex.*")
+	_, err = MaxFlow(g, "s", "nope", Dinic)
+	c.Assert(err, ErrorMatches, "Sink vertex.*")
+	_, err = MaxFlow(g, "s", "s", Dinic)
+	c.Assert(err, ErrorMatches, ".*must be distinct.*")
+	_, err = MaxFlow(g, "s", "t", Algorithm(99))
+	c.Assert(err, ErrorMatches, "Unrecognized.*")
+
+	neg := gogl.Spec().Directed().Weighted().Using(gogl.WeightedArcList{
+		gogl.NewWeightedArc(1, 2, -5),
+	}).Create(al.G).(gogl.WeightedDigraph)
+	_, err = MaxFlow(neg, 1, 2, EdmondsKarp)
+	c.Assert(err, ErrorMatches, ".*negative capacity.*")
+}
@@ -0,0 +1,70 @@
+package flow
+
+// Calculates a maximum flow from s to t using the FIFO push-relabel algorithm.
+func (n *network) pushRelabel(s, t int) float64 {
+	order := len(n.vertices)
+	height := make([]int, order)
+	excess := make([]float64, order)
+	current := make([]int, order) // position in each vertex's edge list
+	var active []int
+
+	// enqueues v if it has just become active
+	activate := func(v int) {
+		if v != s && v != t && excess[v] > 0 {
+			active = append(active, v)
+		}
+	}
+
+	push := func(e int, amount float64) {
+		u, v := n.to[e^1], n.to[e]
+		n.augment(e, amount)
+		excess[u] -= amount
+		wasActive := excess[v] > 0
+		excess[v] += amount
+		if !wasActive {
+			activate(v)
+		}
+	}
+
+	// Start with a preflow that saturates every edge leaving the source.
+	height[s] = order
+	for _, e := range n.adj[s] {
+		if n.cap[e] > 0 {
+			push(e, n.cap[e])
+		}
+	}
+
+	for len(active) > 0 {
+		u := active[0]
+		active = active[1:]
+
+		// discharge u
+		for excess[u] > 0 {
+			if current[u] == len(n.adj[u]) {
+				// relabel: lift u just above its lowest residual neighbor
+				min := 2 * order
+				for _, e := range n.adj[u] {
+					if n.cap[e] > 0 && height[n.to[e]] < min {
+						min = height[n.to[e]]
+					}
+				}
+				height[u] = min + 1
+				current[u] = 0
+				continue
+			}
+
+			e := n.adj[u][current[u]]
+			if v := n.to[e]; n.cap[e] > 0 && height[u] == height[v]+1 {
+				amount := excess[u]
+				if n.cap[e] < amount {
+					amount = n.cap[e]
+				}
+				push(e, amount)
+			} else {
+				current[u]++
+			}
+		}
+	}
+
+	return excess[t]
+}
@@ -198,6 +198,46 @@ type MutableDataGraph interface {
 	DataEdgeSetMutator
 }
 
+// PersistentGraph describes a graph with basic edges that is never modified in place.
+// Instead, each of its mutators returns a new version of the graph with the change
+// applied, sharing structure with the version it was called on. Earlier versions
+// remain valid and unchanged, and are cheap to keep.
+//
+// Mutators return a Graph; type assert it back to PersistentGraph to continue.
+type PersistentGraph interface {
+	Graph
+	PersistentVertexSetMutator
+	PersistentEdgeSetMutator
+}
+
+// PersistentDigraph is the directed counterpart to PersistentGraph.
+type PersistentDigraph interface {
+	Digraph
+	PersistentVertexSetMutator
+	PersistentArcSetMutator
+}
+
+// PersistentWeightedGraph is the persistent version of a weighted graph.
+type PersistentWeightedGraph interface {
+	WeightedGraph
+	PersistentVertexSetMutator
+	PersistentWeightedEdgeSetMutator
+}
+
+// PersistentLabeledGraph is the persistent version of a labeled graph.
+type PersistentLabeledGraph interface {
+	LabeledGraph
+	PersistentVertexSetMutator
+	PersistentLabeledEdgeSetMutator
+}
+
+// PersistentDataGraph is the persistent version of a data graph.
+type PersistentDataGraph interface {
+	DataGraph
+	PersistentVertexSetMutator
+	PersistentDataEdgeSetMutator
+}
+
 /* Atomic graph interfaces */
 
 // EdgeSteps are used as arguments to various enumerators. They are called once for each edge produced by the enumerator.
@@ -355,6 +395,63 @@ type DataArcSetMutator interface {
 	RemoveArcs(arcs ...DataArc)
 }
 
+// A PersistentVertexSetMutator produces new versions of a graph with vertices added
+// or removed, leaving the original unchanged.
+type PersistentVertexSetMutator interface {
+	// Returns a version of the graph in which the provided vertices are present.
+	EnsureVertex(...Vertex) Graph
+	// Returns a version of the graph without the provided vertices.
+	RemoveVertex(...Vertex) Graph
+}
+
+// A PersistentEdgeSetMutator produces new versions of a graph with edges added or removed.
+type PersistentEdgeSetMutator interface {
+	AddEdges(edges ...Edge) Graph
+	RemoveEdges(edges ...Edge) Graph
+}
+
+// A PersistentArcSetMutator produces new versions of a graph with arcs added or removed.
+type PersistentArcSetMutator interface {
+	AddArcs(arcs ...Arc) Graph
+	RemoveArcs(arcs ...Arc) Graph
+}
+
+// A PersistentWeightedEdgeSetMutator produces new versions of a graph with weighted edges added or removed.
+type PersistentWeightedEdgeSetMutator interface {
+	AddEdges(edges ...WeightedEdge) Graph
+	RemoveEdges(edges ...WeightedEdge) Graph
+}
+
+// A PersistentWeightedArcSetMutator produces new versions of a graph with weighted arcs added or removed.
+type PersistentWeightedArcSetMutator interface {
+	AddArcs(arcs ...WeightedArc) Graph
+	RemoveArcs(arcs ...WeightedArc) Graph
+}
+
+// A PersistentLabeledEdgeSetMutator produces new versions of a graph with labeled edges added or removed.
+type PersistentLabeledEdgeSetMutator interface {
+	AddEdges(edges ...LabeledEdge) Graph
+	RemoveEdges(edges ...LabeledEdge) Graph
+}
+
+// A PersistentLabeledArcSetMutator produces new versions of a graph with labeled arcs added or removed.
+type PersistentLabeledArcSetMutator interface {
+	AddArcs(arcs ...LabeledArc) Graph
+	RemoveArcs(arcs ...LabeledArc) Graph
+}
+
+// A PersistentDataEdgeSetMutator produces new versions of a graph with data edges added or removed.
+type PersistentDataEdgeSetMutator interface {
+	AddEdges(edges ...DataEdge) Graph
+	RemoveEdges(edges ...DataEdge) Graph
+}
+
+// A PersistentDataArcSetMutator produces new versions of a graph with data arcs added or removed.
+type PersistentDataArcSetMutator interface {
+	AddArcs(arcs ...DataArc) Graph
+	RemoveArcs(arcs ...DataArc) Graph
+}
+
 /* Optional optimization interfaces
 
 These interfaces describe behaviors and information about a graph which can be
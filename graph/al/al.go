@@ -10,8 +10,11 @@ Adjacency lists are a relatively simple graph representation. They maintain
 a list of vertices, storing information about edge membership relative to
 those vertices. This makes vertex-centric operations generally more
 efficient, and edge-centric operations generally less efficient, as edges
-are represented implicitly. It also makes them inappropriate for more
-complex graph types, such as multigraphs.
+are represented implicitly. Multigraphs and pseudographs hold a list of
+edges for each pair of adjacent vertices, so that parallel edges can be
+represented explicitly. Persistent graphs hold their lists in persistent hash
+tries, so that each version of the graph shares most of its structure with
+the last.
 
 gogl's adjacency lists are space-efficient; in a directed graph, the memory
 cost for the entire graph G is proportional to V + E; in an undirected graph,
@@ -22,30 +25,171 @@ var alCreators = map[GraphProperties]func() Graph{
 	GraphProperties(G_IMMUTABLE | G_DIRECTED | G_BASIC | G_SIMPLE): func() Graph {
 		return &immutableDirected{al_basic_immut{al_basic{list: make(map[Vertex]map[Vertex]struct{})}}}
 	},
+	GraphProperties(G_IMMUTABLE | G_UNDIRECTED | G_BASIC | G_SIMPLE): func() Graph {
+		return &immutableUndirected{al_basic_immut{al_basic{list: make(map[Vertex]map[Vertex]struct{})}}}
+	},
 	GraphProperties(G_MUTABLE | G_DIRECTED | G_BASIC | G_SIMPLE): func() Graph {
 		return &mutableDirected{al_basic_mut{al_basic{list: make(map[Vertex]map[Vertex]struct{})}, sync.RWMutex{}}}
 	},
 	GraphProperties(G_MUTABLE | G_UNDIRECTED | G_BASIC | G_SIMPLE): func() Graph {
 		return &mutableUndirected{al_basic_mut{al_basic{list: make(map[Vertex]map[Vertex]struct{})}, sync.RWMutex{}}}
 	},
+	GraphProperties(G_IMMUTABLE | G_DIRECTED | G_WEIGHTED | G_SIMPLE): func() Graph {
+		return &immutableWeightedDirected{baseImmutableWeighted{list: make(map[Vertex]map[Vertex]float64)}}
+	},
 	GraphProperties(G_MUTABLE | G_DIRECTED | G_WEIGHTED | G_SIMPLE): func() Graph {
 		return &weightedDirected{baseWeighted{list: make(map[Vertex]map[Vertex]float64), size: 0, mu: sync.RWMutex{}}}
 	},
+	GraphProperties(G_IMMUTABLE | G_UNDIRECTED | G_WEIGHTED | G_SIMPLE): func() Graph {
+		return &immutableWeightedUndirected{baseImmutableWeighted{list: make(map[Vertex]map[Vertex]float64)}}
+	},
 	GraphProperties(G_MUTABLE | G_UNDIRECTED | G_WEIGHTED | G_SIMPLE): func() Graph {
 		return &weightedUndirected{baseWeighted{list: make(map[Vertex]map[Vertex]float64), size: 0, mu: sync.RWMutex{}}}
 	},
+	GraphProperties(G_IMMUTABLE | G_DIRECTED | G_LABELED | G_SIMPLE): func() Graph {
+		return &immutableLabeledDirected{baseImmutableLabeled{list: make(map[Vertex]map[Vertex]string)}}
+	},
 	GraphProperties(G_MUTABLE | G_DIRECTED | G_LABELED | G_SIMPLE): func() Graph {
 		return &labeledDirected{baseLabeled{list: make(map[Vertex]map[Vertex]string), size: 0, mu: sync.RWMutex{}}}
 	},
+	GraphProperties(G_IMMUTABLE | G_UNDIRECTED | G_LABELED | G_SIMPLE): func() Graph {
+		return &immutableLabeledUndirected{baseImmutableLabeled{list: make(map[Vertex]map[Vertex]string)}}
+	},
 	GraphProperties(G_MUTABLE | G_UNDIRECTED | G_LABELED | G_SIMPLE): func() Graph {
 		return &labeledUndirected{baseLabeled{list: make(map[Vertex]map[Vertex]string), size: 0, mu: sync.RWMutex{}}}
 	},
+	GraphProperties(G_IMMUTABLE | G_DIRECTED | G_DATA | G_SIMPLE): func() Graph {
+		return &immutableDataDirected{baseImmutableData{list: make(map[Vertex]map[Vertex]interface{})}}
+	},
 	GraphProperties(G_MUTABLE | G_DIRECTED | G_DATA | G_SIMPLE): func() Graph {
 		return &dataDirected{baseData{list: make(map[Vertex]map[Vertex]interface{}), size: 0, mu: sync.RWMutex{}}}
 	},
+	GraphProperties(G_IMMUTABLE | G_UNDIRECTED | G_DATA | G_SIMPLE): func() Graph {
+		return &immutableDataUndirected{baseImmutableData{list: make(map[Vertex]map[Vertex]interface{})}}
+	},
 	GraphProperties(G_MUTABLE | G_UNDIRECTED | G_DATA | G_SIMPLE): func() Graph {
 		return &dataUndirected{baseData{list: make(map[Vertex]map[Vertex]interface{}), size: 0, mu: sync.RWMutex{}}}
 	},
+	GraphProperties(G_IMMUTABLE | G_UNDIRECTED | G_BASIC | G_PARALLEL): func() Graph {
+		return newMulti(&immutableMultiUndirected{}, false)
+	},
+	GraphProperties(G_MUTABLE | G_UNDIRECTED | G_BASIC | G_PARALLEL): func() Graph {
+		return newMulti(&mutableMultiUndirected{}, false)
+	},
+	GraphProperties(G_IMMUTABLE | G_DIRECTED | G_BASIC | G_PARALLEL): func() Graph {
+		return newMulti(&immutableMultiDirected{}, false)
+	},
+	GraphProperties(G_MUTABLE | G_DIRECTED | G_BASIC | G_PARALLEL): func() Graph {
+		return newMulti(&mutableMultiDirected{}, false)
+	},
+	GraphProperties(G_IMMUTABLE | G_UNDIRECTED | G_WEIGHTED | G_PARALLEL): func() Graph {
+		return newMulti(&immutableWeightedMultiUndirected{}, false)
+	},
+	GraphProperties(G_MUTABLE | G_UNDIRECTED | G_WEIGHTED | G_PARALLEL): func() Graph {
+		return newMulti(&weightedMultiUndirected{}, false)
+	},
+	GraphProperties(G_IMMUTABLE | G_DIRECTED | G_WEIGHTED | G_PARALLEL): func() Graph {
+		return newMulti(&immutableWeightedMultiDirected{}, false)
+	},
+	GraphProperties(G_MUTABLE | G_DIRECTED | G_WEIGHTED | G_PARALLEL): func() Graph {
+		return newMulti(&weightedMultiDirected{}, false)
+	},
+	GraphProperties(G_IMMUTABLE | G_UNDIRECTED | G_LABELED | G_PARALLEL): func() Graph {
+		return newMulti(&immutableLabeledMultiUndirected{}, false)
+	},
+	GraphProperties(G_MUTABLE | G_UNDIRECTED | G_LABELED | G_PARALLEL): func() Graph {
+		return newMulti(&labeledMultiUndirected{}, false)
+	},
+	GraphProperties(G_IMMUTABLE | G_DIRECTED | G_LABELED | G_PARALLEL): func() Graph {
+		return newMulti(&immutableLabeledMultiDirected{}, false)
+	},
+	GraphProperties(G_MUTABLE | G_DIRECTED | G_LABELED | G_PARALLEL): func() Graph {
+		return newMulti(&labeledMultiDirected{}, false)
+	},
+	GraphProperties(G_IMMUTABLE | G_UNDIRECTED | G_DATA | G_PARALLEL): func() Graph {
+		return newMulti(&immutableDataMultiUndirected{}, false)
+	},
+	GraphProperties(G_MUTABLE | G_UNDIRECTED | G_DATA | G_PARALLEL): func() Graph {
+		return newMulti(&dataMultiUndirected{}, false)
+	},
+	GraphProperties(G_IMMUTABLE | G_DIRECTED | G_DATA | G_PARALLEL): func() Graph {
+		return newMulti(&immutableDataMultiDirected{}, false)
+	},
+	GraphProperties(G_MUTABLE | G_DIRECTED | G_DATA | G_PARALLEL): func() Graph {
+		return newMulti(&dataMultiDirected{}, false)
+	},
+	GraphProperties(G_IMMUTABLE | G_UNDIRECTED | G_BASIC | G_LOOPS | G_PARALLEL): func() Graph {
+		return newMulti(&immutableMultiUndirected{}, true)
+	},
+	GraphProperties(G_MUTABLE | G_UNDIRECTED | G_BASIC | G_LOOPS | G_PARALLEL): func() Graph {
+		return newMulti(&mutableMultiUndirected{}, true)
+	},
+	GraphProperties(G_IMMUTABLE | G_DIRECTED | G_BASIC | G_LOOPS | G_PARALLEL): func() Graph {
+		return newMulti(&immutableMultiDirected{}, true)
+	},
+	GraphProperties(G_MUTABLE | G_DIRECTED | G_BASIC | G_LOOPS | G_PARALLEL): func() Graph {
+		return newMulti(&mutableMultiDirected{}, true)
+	},
+	GraphProperties(G_IMMUTABLE | G_UNDIRECTED | G_WEIGHTED | G_LOOPS | G_PARALLEL): func() Graph {
+		return newMulti(&immutableWeightedMultiUndirected{}, true)
+	},
+	GraphProperties(G_MUTABLE | G_UNDIRECTED | G_WEIGHTED | G_LOOPS | G_PARALLEL): func() Graph {
+		return newMulti(&weightedMultiUndirected{}, true)
+	},
+	GraphProperties(G_IMMUTABLE | G_DIRECTED | G_WEIGHTED | G_LOOPS | G_PARALLEL): func() Graph {
+		return newMulti(&immutableWeightedMultiDirected{}, true)
+	},
+	GraphProperties(G_MUTABLE | G_DIRECTED | G_WEIGHTED | G_LOOPS | G_PARALLEL): func() Graph {
+		return newMulti(&weightedMultiDirected{}, true)
+	},
+	GraphProperties(G_IMMUTABLE | G_UNDIRECTED | G_LABELED | G_LOOPS | G_PARALLEL): func() Graph {
+		return newMulti(&immutableLabeledMultiUndirected{}, true)
+	},
+	GraphProperties(G_MUTABLE | G_UNDIRECTED | G_LABELED | G_LOOPS | G_PARALLEL): func() Graph {
+		return newMulti(&labeledMultiUndirected{}, true)
+	},
+	GraphProperties(G_IMMUTABLE | G_DIRECTED | G_LABELED | G_LOOPS | G_PARALLEL): func() Graph {
+		return newMulti(&immutableLabeledMultiDirected{}, true)
+	},
+	GraphProperties(G_MUTABLE | G_DIRECTED | G_LABELED | G_LOOPS | G_PARALLEL): func() Graph {
+		return newMulti(&labeledMultiDirected{}, true)
+	},
+	GraphProperties(G_IMMUTABLE | G_UNDIRECTED | G_DATA | G_LOOPS | G_PARALLEL): func() Graph {
+		return newMulti(&immutableDataMultiUndirected{}, true)
+	},
+	GraphProperties(G_MUTABLE | G_UNDIRECTED | G_DATA | G_LOOPS | G_PARALLEL): func() Graph {
+		return newMulti(&dataMultiUndirected{}, true)
+	},
+	GraphProperties(G_IMMUTABLE | G_DIRECTED | G_DATA | G_LOOPS | G_PARALLEL): func() Graph {
+		return newMulti(&immutableDataMultiDirected{}, true)
+	},
+	GraphProperties(G_MUTABLE | G_DIRECTED | G_DATA | G_LOOPS | G_PARALLEL): func() Graph {
+		return newMulti(&dataMultiDirected{}, true)
+	},
+	GraphProperties(G_PERSISTENT | G_UNDIRECTED | G_BASIC | G_SIMPLE): func() Graph {
+		return &persistentUndirected{basePersistentUndirected{basePersistent: basePersistent{kind: basicKind}}}
+	},
+	GraphProperties(G_PERSISTENT | G_DIRECTED | G_BASIC | G_SIMPLE): func() Graph {
+		return &persistentDirected{basePersistentDirected{basePersistent: basePersistent{kind: basicKind}}}
+	},
+	GraphProperties(G_PERSISTENT | G_UNDIRECTED | G_WEIGHTED | G_SIMPLE): func() Graph {
+		return &persistentWeightedUndirected{basePersistentUndirected{basePersistent: basePersistent{kind: weightedKind}}}
+	},
+	GraphProperties(G_PERSISTENT | G_DIRECTED | G_WEIGHTED | G_SIMPLE): func() Graph {
+		return &persistentWeightedDirected{basePersistentDirected{basePersistent: basePersistent{kind: weightedKind}}}
+	},
+	GraphProperties(G_PERSISTENT | G_UNDIRECTED | G_LABELED | G_SIMPLE): func() Graph {
+		return &persistentLabeledUndirected{basePersistentUndirected{basePersistent: basePersistent{kind: labeledKind}}}
+	},
+	GraphProperties(G_PERSISTENT | G_DIRECTED | G_LABELED | G_SIMPLE): func() Graph {
+		return &persistentLabeledDirected{basePersistentDirected{basePersistent: basePersistent{kind: labeledKind}}}
+	},
+	GraphProperties(G_PERSISTENT | G_UNDIRECTED | G_DATA | G_SIMPLE): func() Graph {
+		return &persistentDataUndirected{basePersistentUndirected{basePersistent: basePersistent{kind: dataKind}}}
+	},
+	GraphProperties(G_PERSISTENT | G_DIRECTED | G_DATA | G_SIMPLE): func() Graph {
+		return &persistentDataDirected{basePersistentDirected{basePersistent: basePersistent{kind: dataKind}}}
+	},
 }
 
 // Create a graph implementation in the adjacency list style from the provided GraphSpec.
@@ -53,28 +197,70 @@ var alCreators = map[GraphProperties]func() Graph{
 // If the GraphSpec contains a GraphSource, it will be imported into the provided graph.
 // If the GraphSpec indicates a graph type that is not currently implemented, this function
 // will panic.
+//
+// Where the GraphSpec permits loops or parallel edges, the implementation chosen permits
+// at least those; a spec permitting only loops will therefore produce a pseudograph.
 func G(gs GraphSpec) Graph {
+	gf := findCreator(gs.Props)
+	if gf == nil {
+		panic("No graph implementation found for spec")
+	}
+
+	if gs.Source == nil {
+		return gf()
+	}
+
+	if gs.Props&G_DIRECTED == G_DIRECTED {
+		if dgs, ok := gs.Source.(DigraphSource); ok {
+			return functorToDirectedAdjacencyList(dgs, gf().(al_digraph))
+		}
+		panic("Cannot create a digraph from a graph.")
+	}
+	return functorToAdjacencyList(gs.Source, gf().(al_graph))
+}
+
+const (
+	multiplicity = G_SIMPLE | G_LOOPS | G_PARALLEL
+	mutability   = G_IMMUTABLE | G_PERSISTENT
+)
+
+// Finds the creator for the implementation best matching the provided properties.
+//
+// Directedness, edge type and mutability must match exactly. Of the implementations
+// whose multiplicity permits everything the properties ask for, the one permitting
+// the least beyond that is chosen.
+func findCreator(props GraphProperties) (creator func() Graph) {
+	var extra int
 	for gp, gf := range alCreators {
+		if gp&^multiplicity&^props != 0 {
+			continue
+		}
 
-		// TODO satisfiability here is not so narrow
-		if gp&^gs.Props == 0 {
-			if gs.Source != nil {
-				if gs.Props&G_DIRECTED == G_DIRECTED {
-					if dgs, ok := gs.Source.(DigraphSource); ok {
-						return functorToDirectedAdjacencyList(dgs, gf().(al_digraph))
-					} else {
-						panic("Cannot create a digraph from a graph.")
-					}
-				} else {
-					return functorToAdjacencyList(gs.Source, gf().(al_graph))
-				}
-			} else {
-				return gf()
-			}
+		// Persistence implies mutability, so a mutable implementation would otherwise match.
+		if gp&mutability != props&mutability {
+			continue
+		}
+
+		// A simple graph permits nothing beyond itself, so it must be asked for exactly.
+		want, have := props&multiplicity, gp&multiplicity
+		if want&^have != 0 || (have&G_SIMPLE != 0) != (want&G_SIMPLE != 0) {
+			continue
+		}
+
+		if n := bits(have &^ want); creator == nil || n < extra {
+			creator, extra = gf, n
 		}
 	}
 
-	panic("No graph implementation found for spec")
+	return
+}
+
+// Counts the flags set in the provided properties.
+func bits(gp GraphProperties) (n int) {
+	for ; gp != 0; gp &= gp - 1 {
+		n++
+	}
+	return
 }
 
 type al_basic struct {
@@ -554,3 +554,331 @@ func (g *dataUndirected) RemoveEdges(edges ...DataEdge) {
 		}
 	}
 }
+
+/* Immutable data implementations */
+
+// The lock-free base for immutable data graphs, as with baseImmutableWeighted.
+type baseImmutableData struct {
+	list map[Vertex]map[Vertex]interface{}
+	size int
+}
+
+// Traverses the graph's vertices in random order, passing each vertex to the
+// provided closure.
+func (g *baseImmutableData) Vertices(f VertexStep) {
+	for v := range g.list {
+		if f(v) {
+			return
+		}
+	}
+}
+
+// Indicates whether or not the given vertex is present in the graph.
+func (g *baseImmutableData) HasVertex(vertex Vertex) bool {
+	return g.hasVertex(vertex)
+}
+
+// Indicates whether or not the given vertex is present in the graph.
+func (g *baseImmutableData) hasVertex(vertex Vertex) (exists bool) {
+	_, exists = g.list[vertex]
+	return
+}
+
+// Returns the order (number of vertices) in the graph.
+func (g *baseImmutableData) Order() int {
+	return len(g.list)
+}
+
+// Returns the size (number of edges) in the graph.
+func (g *baseImmutableData) Size() int {
+	return g.size
+}
+
+// Adds the provided vertices to the graph. If a provided vertex is
+// already present in the graph, it is a no-op (for that vertex only).
+func (g *baseImmutableData) ensureVertex(vertices ...Vertex) {
+	for _, vertex := range vertices {
+		if !g.hasVertex(vertex) {
+			g.list[vertex] = make(map[Vertex]interface{}, 10)
+		}
+	}
+}
+
+type immutableDataDirected struct {
+	baseImmutableData
+}
+
+// Returns the outdegree of the provided vertex. If the vertex is not present in the
+// graph, the second return value will be false.
+func (g *immutableDataDirected) OutDegreeOf(vertex Vertex) (degree int, exists bool) {
+	if exists = g.hasVertex(vertex); exists {
+		degree = len(g.list[vertex])
+	}
+	return
+}
+
+// Returns the indegree of the provided vertex. If the vertex is not present in the
+// graph, the second return value will be false.
+//
+// Note that getting indegree is inefficient for directed adjacency lists; it requires
+// a full scan of the graph's edge set.
+func (g *immutableDataDirected) InDegreeOf(vertex Vertex) (degree int, exists bool) {
+	return inDegreeOf(g, vertex)
+}
+
+// Returns the degree of the given vertex, counting both in and out-edges.
+func (g *immutableDataDirected) DegreeOf(vertex Vertex) (degree int, exists bool) {
+	indegree, exists := inDegreeOf(g, vertex)
+	outdegree, exists := g.OutDegreeOf(vertex)
+	return indegree + outdegree, exists
+}
+
+// Enumerates the set of all edges incident to the provided vertex.
+func (g *immutableDataDirected) IncidentTo(v Vertex, f EdgeStep) {
+	eachEdgeIncidentToDirected(g, v, f)
+}
+
+// Enumerates the vertices adjacent to the provided vertex.
+func (g *immutableDataDirected) AdjacentTo(start Vertex, f VertexStep) {
+	g.IncidentTo(start, func(e Edge) bool {
+		u, v := e.Both()
+		if u == start {
+			return f(v)
+		} else {
+			return f(u)
+		}
+	})
+}
+
+// Enumerates the set of out-edges for the provided vertex.
+func (g *immutableDataDirected) ArcsFrom(v Vertex, f ArcStep) {
+	if !g.hasVertex(v) {
+		return
+	}
+
+	for adjacent, data := range g.list[v] {
+		if f(NewDataArc(v, adjacent, data)) {
+			return
+		}
+	}
+}
+
+func (g *immutableDataDirected) SuccessorsOf(v Vertex, f VertexStep) {
+	eachVertexInAdjacencyList(g.list, v, f)
+}
+
+// Enumerates the set of in-edges for the provided vertex.
+func (g *immutableDataDirected) ArcsTo(v Vertex, f ArcStep) {
+	if !g.hasVertex(v) {
+		return
+	}
+
+	for candidate, adjacent := range g.list {
+		for target, data := range adjacent {
+			if target == v {
+				if f(NewDataArc(candidate, target, data)) {
+					return
+				}
+			}
+		}
+	}
+}
+
+func (g *immutableDataDirected) PredecessorsOf(v Vertex, f VertexStep) {
+	eachPredecessorOf(g.list, v, f)
+}
+
+// Traverses the set of edges in the graph, passing each edge to the
+// provided closure.
+func (g *immutableDataDirected) Edges(f EdgeStep) {
+	for source, adjacent := range g.list {
+		for target, data := range adjacent {
+			if f(NewDataEdge(source, target, data)) {
+				return
+			}
+		}
+	}
+}
+
+// Traverses the set of arcs in the graph, passing each arc to the
+// provided closure.
+func (g *immutableDataDirected) Arcs(f ArcStep) {
+	for source, adjacent := range g.list {
+		for target, data := range adjacent {
+			if f(NewDataArc(source, target, data)) {
+				return
+			}
+		}
+	}
+}
+
+// Indicates whether or not the given edge is present in the graph. It matches
+// based solely on the presence of an edge, disregarding edge data.
+func (g *immutableDataDirected) HasEdge(edge Edge) bool {
+	u, v := edge.Both()
+	_, exists := g.list[u][v]
+	if !exists {
+		_, exists = g.list[v][u]
+	}
+	return exists
+}
+
+// Indicates whether or not the given arc is present in the graph.
+func (g *immutableDataDirected) HasArc(arc Arc) bool {
+	_, exists := g.list[arc.Source()][arc.Target()]
+	return exists
+}
+
+// Indicates whether or not the given data edge is present in the graph.
+// It will only match if the provided DataEdge has the same data as
+// the edge contained in the graph.
+func (g *immutableDataDirected) HasDataEdge(edge DataEdge) bool {
+	u, v := edge.Both()
+	if data, exists := g.list[u][v]; exists {
+		return data == edge.Data()
+	} else if data, exists = g.list[v][u]; exists {
+		return data == edge.Data()
+	}
+	return false
+}
+
+// Indicates whether or not the given data arc is present in the graph.
+// It will only match if the provided DataArc has the same data as
+// the arc contained in the graph.
+func (g *immutableDataDirected) HasDataArc(arc DataArc) bool {
+	if data, exists := g.list[arc.Source()][arc.Target()]; exists {
+		return data == arc.Data()
+	}
+	return false
+}
+
+// Returns the density of the graph. Density is the ratio of edge count to the
+// number of edges there would be in complete graph (maximum edge count).
+func (g *immutableDataDirected) Density() float64 {
+	order := g.Order()
+	return float64(g.Size()) / float64(order*(order-1))
+}
+
+// Returns a graph with the same vertex and edge set, but with the
+// directionality of all its edges reversed.
+//
+// This implementation returns a new graph object (doubling memory use),
+// but not all implementations do so.
+func (g *immutableDataDirected) Transpose() Digraph {
+	g2 := &immutableDataDirected{}
+	g2.list = make(map[Vertex]map[Vertex]interface{}, len(g.list))
+	g2.size = g.size
+
+	for source, adjacent := range g.list {
+		g2.ensureVertex(source)
+		for target, data := range adjacent {
+			g2.ensureVertex(target)
+			g2.list[target][source] = data
+		}
+	}
+
+	return g2
+}
+
+// Adds a new arc to the graph.
+func (g *immutableDataDirected) addArcs(arcs ...DataArc) {
+	for _, arc := range arcs {
+		g.ensureVertex(arc.Source(), arc.Target())
+
+		if _, exists := g.list[arc.Source()][arc.Target()]; !exists {
+			g.list[arc.Source()][arc.Target()] = arc.Data()
+			g.size++
+		}
+	}
+}
+
+type immutableDataUndirected struct {
+	baseImmutableData
+}
+
+// Returns the degree of the provided vertex. If the vertex is not present in the
+// graph, the second return value will be false.
+func (g *immutableDataUndirected) DegreeOf(vertex Vertex) (degree int, exists bool) {
+	if exists = g.hasVertex(vertex); exists {
+		degree = len(g.list[vertex])
+	}
+	return
+}
+
+// Traverses the set of edges in the graph, passing each edge to the
+// provided closure.
+func (g *immutableDataUndirected) Edges(f EdgeStep) {
+	visited := set.NewNonTS()
+
+	var e DataEdge
+	for source, adjacent := range g.list {
+		for target, data := range adjacent {
+			e = NewDataEdge(source, target, data)
+			if !visited.Has(NewEdge(e.Both())) {
+				visited.Add(NewEdge(target, source))
+				if f(e) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// Enumerates the set of all edges incident to the provided vertex.
+func (g *immutableDataUndirected) IncidentTo(v Vertex, f EdgeStep) {
+	if !g.hasVertex(v) {
+		return
+	}
+
+	for adjacent, data := range g.list[v] {
+		if f(NewDataEdge(v, adjacent, data)) {
+			return
+		}
+	}
+}
+
+// Enumerates the vertices adjacent to the provided vertex.
+func (g *immutableDataUndirected) AdjacentTo(vertex Vertex, f VertexStep) {
+	eachVertexInAdjacencyList(g.list, vertex, f)
+}
+
+// Indicates whether or not the given edge is present in the graph. It matches
+// based solely on the presence of an edge, disregarding edge data.
+func (g *immutableDataUndirected) HasEdge(edge Edge) bool {
+	u, v := edge.Both()
+	_, exists := g.list[u][v]
+	return exists
+}
+
+// Indicates whether or not the given data edge is present in the graph.
+// It will only match if the provided DataEdge has the same data as
+// the edge contained in the graph.
+func (g *immutableDataUndirected) HasDataEdge(edge DataEdge) bool {
+	u, v := edge.Both()
+	if data, exists := g.list[u][v]; exists {
+		return edge.Data() == data
+	}
+	return false
+}
+
+// Returns the density of the graph. Density is the ratio of edge count to the
+// number of edges there would be in complete graph (maximum edge count).
+func (g *immutableDataUndirected) Density() float64 {
+	order := g.Order()
+	return 2 * float64(g.Size()) / float64(order*(order-1))
+}
+
+// Adds a new edge to the graph.
+func (g *immutableDataUndirected) addEdges(edges ...DataEdge) {
+	for _, edge := range edges {
+		u, v := edge.Both()
+		g.ensureVertex(u, v)
+
+		if _, exists := g.list[u][v]; !exists {
+			w := edge.Data()
+			g.list[u][v] = w
+			g.list[v][u] = w
+			g.size++
+		}
+	}
+}
@@ -7,6 +7,7 @@ import (
 
 	. "github.com/sdboyer/gocheck"
 	. "github.com/sdboyer/gogl"
+	"github.com/sdboyer/gogl/graph/csr"
 )
 
 // TODO reimplement with specs
@@ -164,7 +165,11 @@ func benchHasVertex(g Graph, c *C) {
 	}
 }
 
-var bgraph = Spec().Using(bernoulliDistributionGenerator(1000, 50, nil)).Create(G)
+var bsource = bernoulliDistributionGenerator(1000, 50, nil)
+var bgraph = Spec().Using(bsource).Create(G)
+
+// The same graph in compressed sparse row form, for comparison.
+var csrgraph = Spec().Immutable().Using(bsource).Create(csr.G)
 
 func BenchmarkHasVertex(b *testing.B) {
 	for i := 0; i < b.N; i++ {
@@ -188,3 +193,69 @@ func BenchmarkEdges(b *testing.B) {
 		})
 	}
 }
+
+func BenchmarkHasEdge(b *testing.B) {
+	benchHasEdge(bgraph, b)
+}
+
+func BenchmarkAdjacentTo(b *testing.B) {
+	benchAdjacentTo(bgraph, b)
+}
+
+func BenchmarkCreate(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		Spec().Immutable().Using(bsource).Create(G)
+	}
+}
+
+func BenchmarkCSRHasVertex(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		csrgraph.HasVertex(50)
+	}
+}
+
+func BenchmarkCSRVertices(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		csrgraph.Vertices(func(v Vertex) (terminate bool) {
+			return
+		})
+	}
+}
+
+func BenchmarkCSREdges(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		csrgraph.Edges(func(e Edge) (terminate bool) {
+			return
+		})
+	}
+}
+
+func BenchmarkCSRHasEdge(b *testing.B) {
+	benchHasEdge(csrgraph, b)
+}
+
+func BenchmarkCSRAdjacentTo(b *testing.B) {
+	benchAdjacentTo(csrgraph, b)
+}
+
+func BenchmarkCSRCreate(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		Spec().Immutable().Using(bsource).Create(csr.G)
+	}
+}
+
+func benchHasEdge(g Graph, b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		g.HasEdge(NewEdge(i%1000, (i*7)%1000))
+	}
+}
+
+func benchAdjacentTo(g Graph, b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		g.AdjacentTo(i%1000, func(v Vertex) (terminate bool) {
+			return
+		})
+	}
+}
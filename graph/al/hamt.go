@@ -0,0 +1,354 @@
+package al
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+
+	. "github.com/sdboyer/gogl"
+)
+
+/*
+A hamt is a persistent map from vertices to arbitrary values, implemented as a hash
+array mapped trie. Each node of the trie consumes five bits of a key's hash, holding
+a bitmap of which of its 32 slots are occupied and a compressed slice of only those
+slots. Keys whose hashes are identical end up together in a collision node.
+
+A hamt is never modified in place; set and delete return a new hamt which copies
+only the nodes along the path to the key that changed, sharing all others with the
+original. The zero value is an empty map.
+*/
+type hamt struct {
+	root  *hamtNode
+	count int
+}
+
+type hamtNode struct {
+	bitmap    uint32
+	entries   []hamtEntry
+	collision bool // all entries are leaves sharing the same hash; bitmap is unused
+}
+
+// An entry is either a leaf, holding a key and its value, or a link to a child node.
+type hamtEntry struct {
+	hash  uint32
+	key   Vertex
+	value interface{}
+	child *hamtNode
+}
+
+const (
+	hamtBits = 5
+	hamtMask = 1<<hamtBits - 1
+)
+
+// Returns the number of keys in the map.
+func (m hamt) len() int {
+	return m.count
+}
+
+// Returns the value held for the provided key, and whether or not the key is present.
+func (m hamt) get(key Vertex) (value interface{}, exists bool) {
+	h := hashVertex(key)
+	for n, shift := m.root, uint(0); n != nil; shift += hamtBits {
+		if n.collision {
+			for _, e := range n.entries {
+				if e.key == key {
+					return e.value, true
+				}
+			}
+			return
+		}
+
+		bit := uint32(1) << (h >> shift & hamtMask)
+		if n.bitmap&bit == 0 {
+			return
+		}
+
+		e := n.entries[n.index(bit)]
+		if e.child == nil {
+			if e.key == key {
+				return e.value, true
+			}
+			return
+		}
+		n = e.child
+	}
+	return
+}
+
+// Returns a map in which the provided key holds the provided value.
+func (m hamt) set(key Vertex, value interface{}) hamt {
+	leaf := hamtEntry{hash: hashVertex(key), key: key, value: value}
+	if m.root == nil {
+		m.root = &hamtNode{}
+	}
+
+	var added bool
+	m.root, added = m.root.set(0, leaf)
+	if added {
+		m.count++
+	}
+	return m
+}
+
+// Returns a map without the provided key. If the key is not present, the map is
+// returned as-is.
+func (m hamt) delete(key Vertex) hamt {
+	if m.root == nil {
+		return m
+	}
+
+	root, removed := m.root.delete(0, hashVertex(key), key)
+	if removed {
+		m.root = root
+		m.count--
+	}
+	return m
+}
+
+// Passes each key and its value to the provided closure, in no particular order.
+// Returns true if the closure terminated the traversal early.
+func (m hamt) each(f func(key Vertex, value interface{}) (terminate bool)) bool {
+	if m.root == nil {
+		return false
+	}
+	return m.root.each(f)
+}
+
+// Returns the position in the compressed entry slice of the slot for the provided bit.
+func (n *hamtNode) index(bit uint32) int {
+	return popcount(n.bitmap & (bit - 1))
+}
+
+// Returns a copy of the node with the provided leaf in place, and whether the
+// leaf's key was newly added (rather than replacing an existing value).
+func (n *hamtNode) set(shift uint, leaf hamtEntry) (*hamtNode, bool) {
+	if n.collision {
+		if h := n.entries[0].hash; h != leaf.hash {
+			// The new key only shares a prefix with the colliding ones; branch them apart.
+			branch := &hamtNode{bitmap: 1 << (h >> shift & hamtMask), entries: []hamtEntry{{child: n}}}
+			return branch.set(shift, leaf)
+		}
+
+		for i, e := range n.entries {
+			if e.key == leaf.key {
+				return n.replace(i, leaf), false
+			}
+		}
+		return n.insert(len(n.entries), 0, leaf), true
+	}
+
+	bit := uint32(1) << (leaf.hash >> shift & hamtMask)
+	i := n.index(bit)
+	if n.bitmap&bit == 0 {
+		return n.insert(i, bit, leaf), true
+	}
+
+	e := n.entries[i]
+	switch {
+	case e.child != nil:
+		child, added := e.child.set(shift+hamtBits, leaf)
+		return n.replace(i, hamtEntry{child: child}), added
+	case e.key == leaf.key:
+		return n.replace(i, leaf), false
+	default:
+		return n.replace(i, hamtEntry{child: merge(shift+hamtBits, e, leaf)}), true
+	}
+}
+
+// Returns a copy of the node without the provided key, and whether the key was
+// present at all. If the node is left empty, the returned node is nil.
+func (n *hamtNode) delete(shift uint, h uint32, key Vertex) (*hamtNode, bool) {
+	if n.collision {
+		for i, e := range n.entries {
+			if e.key == key {
+				return n.remove(i, 0), true
+			}
+		}
+		return n, false
+	}
+
+	bit := uint32(1) << (h >> shift & hamtMask)
+	if n.bitmap&bit == 0 {
+		return n, false
+	}
+
+	i := n.index(bit)
+	e := n.entries[i]
+	if e.child == nil {
+		if e.key != key {
+			return n, false
+		}
+		return n.remove(i, bit), true
+	}
+
+	child, removed := e.child.delete(shift+hamtBits, h, key)
+	switch {
+	case !removed:
+		return n, false
+	case child == nil:
+		return n.remove(i, bit), true
+	case len(child.entries) == 1 && child.entries[0].child == nil:
+		// A lone leaf needs no node of its own; pull it up into this one.
+		return n.replace(i, child.entries[0]), true
+	default:
+		return n.replace(i, hamtEntry{child: child}), true
+	}
+}
+
+func (n *hamtNode) each(f func(key Vertex, value interface{}) (terminate bool)) bool {
+	for _, e := range n.entries {
+		if e.child != nil {
+			if e.child.each(f) {
+				return true
+			}
+		} else if f(e.key, e.value) {
+			return true
+		}
+	}
+	return false
+}
+
+// Returns a copy of the node with the i'th entry replaced.
+func (n *hamtNode) replace(i int, e hamtEntry) *hamtNode {
+	entries := make([]hamtEntry, len(n.entries))
+	copy(entries, n.entries)
+	entries[i] = e
+	return &hamtNode{bitmap: n.bitmap, entries: entries, collision: n.collision}
+}
+
+// Returns a copy of the node with an entry inserted at position i, occupying the
+// slot for the provided bit.
+func (n *hamtNode) insert(i int, bit uint32, e hamtEntry) *hamtNode {
+	entries := make([]hamtEntry, len(n.entries)+1)
+	copy(entries, n.entries[:i])
+	entries[i] = e
+	copy(entries[i+1:], n.entries[i:])
+	return &hamtNode{bitmap: n.bitmap | bit, entries: entries, collision: n.collision}
+}
+
+// Returns a copy of the node with the entry at position i, occupying the slot for
+// the provided bit, removed; or nil, if that was the last entry.
+func (n *hamtNode) remove(i int, bit uint32) *hamtNode {
+	if len(n.entries) == 1 {
+		return nil
+	}
+
+	entries := make([]hamtEntry, len(n.entries)-1)
+	copy(entries, n.entries[:i])
+	copy(entries[i:], n.entries[i+1:])
+	return &hamtNode{bitmap: n.bitmap &^ bit, entries: entries, collision: n.collision}
+}
+
+// Creates a node holding two leaves with different keys, descending as many levels
+// as it takes for their hashes to diverge.
+func merge(shift uint, a, b hamtEntry) *hamtNode {
+	if a.hash == b.hash {
+		return &hamtNode{entries: []hamtEntry{a, b}, collision: true}
+	}
+
+	// Hashes that differ must do so within the 32 bits consumed by the first seven levels.
+	ia, ib := a.hash>>shift&hamtMask, b.hash>>shift&hamtMask
+	switch {
+	case ia == ib:
+		return &hamtNode{bitmap: 1 << ia, entries: []hamtEntry{{child: merge(shift+hamtBits, a, b)}}}
+	case ia < ib:
+		return &hamtNode{bitmap: 1<<ia | 1<<ib, entries: []hamtEntry{a, b}}
+	default:
+		return &hamtNode{bitmap: 1<<ia | 1<<ib, entries: []hamtEntry{b, a}}
+	}
+}
+
+func popcount(x uint32) (n int) {
+	for ; x != 0; x &= x - 1 {
+		n++
+	}
+	return
+}
+
+// An FNV-1a hash.
+type hasher uint32
+
+const (
+	fnvOffset hasher = 2166136261
+	fnvPrime  hasher = 16777619
+)
+
+func (h *hasher) writeUint64(x uint64) {
+	for i := 0; i < 8; i++ {
+		*h ^= hasher(byte(x))
+		*h *= fnvPrime
+		x >>= 8
+	}
+}
+
+func (h *hasher) writeString(s string) {
+	for i := 0; i < len(s); i++ {
+		*h ^= hasher(s[i])
+		*h *= fnvPrime
+	}
+}
+
+// Hashes a vertex. Vertices that are equal (by ==) always hash the same, so any
+// comparable type may be used; as with Go's own maps, others cause a panic.
+func hashVertex(v Vertex) uint32 {
+	h := fnvOffset
+
+	// Fast paths for the most common vertex types.
+	switch v := v.(type) {
+	case string:
+		h.writeString(v)
+	case int:
+		h.writeUint64(uint64(v))
+	default:
+		h.writeValue(reflect.ValueOf(v))
+	}
+	return uint32(h)
+}
+
+func (h *hasher) writeValue(rv reflect.Value) {
+	switch rv.Kind() {
+	case reflect.Invalid:
+		h.writeUint64(0)
+	case reflect.Bool:
+		if rv.Bool() {
+			h.writeUint64(1)
+		} else {
+			h.writeUint64(0)
+		}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		h.writeUint64(uint64(rv.Int()))
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		h.writeUint64(rv.Uint())
+	case reflect.Float32, reflect.Float64:
+		h.writeFloat(rv.Float())
+	case reflect.Complex64, reflect.Complex128:
+		c := rv.Complex()
+		h.writeFloat(real(c))
+		h.writeFloat(imag(c))
+	case reflect.String:
+		h.writeString(rv.String())
+	case reflect.Ptr, reflect.Chan, reflect.UnsafePointer:
+		h.writeUint64(uint64(rv.Pointer()))
+	case reflect.Interface:
+		h.writeValue(rv.Elem())
+	case reflect.Array:
+		for i := 0; i < rv.Len(); i++ {
+			h.writeValue(rv.Index(i))
+		}
+	case reflect.Struct:
+		for i := 0; i < rv.NumField(); i++ {
+			h.writeValue(rv.Field(i))
+		}
+	default:
+		panic(fmt.Sprintf("Cannot hash vertex of unhashable type %s.", rv.Type()))
+	}
+}
+
+func (h *hasher) writeFloat(f float64) {
+	if f == 0 {
+		f = 0 // -0 == +0, so they must hash the same
+	}
+	h.writeUint64(math.Float64bits(f))
+}
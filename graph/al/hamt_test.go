@@ -0,0 +1,152 @@
+package al
+
+import (
+	stdrand "math/rand"
+
+	. "github.com/sdboyer/gocheck"
+	. "github.com/sdboyer/gogl"
+)
+
+type HamtSuite struct{}
+
+var _ = Suite(&HamtSuite{})
+
+// Verifies a hamt against a builtin map of what it should contain.
+func checkHamt(c *C, m hamt, expected map[Vertex]interface{}) {
+	c.Assert(m.len(), Equals, len(expected))
+	for k, v := range expected {
+		got, exists := m.get(k)
+		c.Assert(exists, Equals, true, Commentf("key %v", k))
+		c.Assert(got, Equals, v)
+	}
+
+	var n int
+	m.each(func(k Vertex, v interface{}) (terminate bool) {
+		c.Assert(expected[k], Equals, v)
+		n++
+		return
+	})
+	c.Assert(n, Equals, len(expected))
+}
+
+func (s *HamtSuite) TestSetGetDelete(c *C) {
+	var m hamt
+	_, exists := m.get("foo")
+	c.Assert(exists, Equals, false)
+	c.Assert(m.delete("foo").len(), Equals, 0)
+
+	r := stdrand.New(stdrand.NewSource(1))
+	expected := make(map[Vertex]interface{})
+	for i := 0; i < 5000; i++ {
+		k := r.Intn(2000)
+		if r.Intn(3) == 0 {
+			m = m.delete(k)
+			delete(expected, k)
+		} else {
+			m = m.set(k, i)
+			expected[k] = i
+		}
+	}
+	checkHamt(c, m, expected)
+
+	for k := range expected {
+		m = m.delete(k)
+	}
+	c.Assert(m.len(), Equals, 0)
+	c.Assert(m.root, IsNil)
+}
+
+func (s *HamtSuite) TestVersionsAreIndependent(c *C) {
+	var versions []hamt
+	var m hamt
+	for i := 0; i < 100; i++ {
+		m = m.set(i, i)
+		versions = append(versions, m)
+	}
+	m = m.set(0, "changed").delete(50)
+
+	for i, v := range versions {
+		expected := make(map[Vertex]interface{})
+		for j := 0; j <= i; j++ {
+			expected[j] = j
+		}
+		checkHamt(c, v, expected)
+	}
+
+	val, _ := m.get(0)
+	c.Assert(val, Equals, "changed")
+	_, exists := m.get(50)
+	c.Assert(exists, Equals, false)
+}
+
+// Finds a key in the trie under the provided hash, rather than the key's own.
+func findWithHash(n *hamtNode, h uint32, key Vertex) bool {
+	for shift := uint(0); n != nil && !n.collision; shift += hamtBits {
+		bit := uint32(1) << (h >> shift & hamtMask)
+		if n.bitmap&bit == 0 {
+			return false
+		}
+		e := n.entries[n.index(bit)]
+		if e.child == nil {
+			return e.key == key
+		}
+		n = e.child
+	}
+
+	if n != nil {
+		for _, e := range n.entries {
+			if e.key == key {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func (s *HamtSuite) TestCollisions(c *C) {
+	// Leaves are built directly, so that every key can be given the same hash.
+	root := &hamtNode{}
+	for _, k := range []string{"a", "b", "c"} {
+		root, _ = root.set(0, hamtEntry{hash: 42, key: k})
+	}
+	for _, k := range []string{"a", "b", "c"} {
+		c.Assert(findWithHash(root, 42, k), Equals, true)
+	}
+
+	// a key sharing only the low bits of the hash splits the collision node off
+	split, added := root.set(0, hamtEntry{hash: 42 | 1<<20, key: "d"})
+	c.Assert(added, Equals, true)
+	c.Assert(findWithHash(split, 42|1<<20, "d"), Equals, true)
+	for _, k := range []string{"a", "b", "c"} {
+		c.Assert(findWithHash(split, 42, k), Equals, true)
+	}
+
+	// the last key left in a collision node is pulled back up as a plain leaf
+	root, _ = root.delete(0, 42, "b")
+	root, _ = root.delete(0, 42, "c")
+	c.Assert(findWithHash(root, 42, "a"), Equals, true)
+	c.Assert(findWithHash(root, 42, "b"), Equals, false)
+	c.Assert(root.entries[0].child, IsNil)
+}
+
+func (s *HamtSuite) TestHashVertex(c *C) {
+	type pair struct {
+		a interface{}
+		b float64
+	}
+	x, y := new(int), new(int)
+
+	// equal vertices must hash equally
+	c.Assert(hashVertex(pair{"foo", 0}), Equals, hashVertex(pair{"foo", 0}))
+	c.Assert(hashVertex(pair{1, 0}), Not(Equals), hashVertex(pair{2, 0}))
+	c.Assert(hashVertex(x), Equals, hashVertex(x))
+	c.Assert(hashVertex(x), Not(Equals), hashVertex(y))
+	c.Assert(hashVertex([2]string{"a", "b"}), Equals, hashVertex([2]string{"a", "b"}))
+
+	negzero := 0.0
+	negzero = -negzero
+	c.Assert(pair{nil, negzero} == pair{nil, 0}, Equals, true)
+	c.Assert(hashVertex(pair{nil, negzero}), Equals, hashVertex(pair{nil, 0}))
+
+	c.Assert(func() { hashVertex([]int{1}) }, PanicMatches, "Cannot hash vertex of unhashable type.*")
+}
@@ -0,0 +1,87 @@
+package al
+
+import (
+	"sync"
+
+	. "github.com/sdboyer/gocheck"
+	. "github.com/sdboyer/gogl"
+)
+
+type ImmutableSuite struct{}
+
+var _ = Suite(&ImmutableSuite{})
+
+func (s *ImmutableSuite) TestCreate(c *C) {
+	wel := WeightedEdgeList{
+		NewWeightedEdge("foo", "bar", 1.5),
+		NewWeightedEdge("bar", "baz", 2),
+	}
+
+	g := Spec().Immutable().Weighted().Using(wel).Create(G)
+	_, ok := g.(*immutableWeightedUndirected)
+	c.Assert(ok, Equals, true)
+	c.Assert(g.(WeightedGraph).HasWeightedEdge(NewWeightedEdge("baz", "bar", 2)), Equals, true)
+
+	// immutable graphs expose no mutators
+	_, ok = g.(VertexSetMutator)
+	c.Assert(ok, Equals, false)
+	_, ok = g.(WeightedEdgeSetMutator)
+	c.Assert(ok, Equals, false)
+
+	specs := map[GraphSpec]interface{}{
+		Spec().Immutable():                         &immutableUndirected{},
+		Spec().Immutable().Directed().Weighted():   &immutableWeightedDirected{},
+		Spec().Immutable().Labeled():               &immutableLabeledUndirected{},
+		Spec().Immutable().Directed().Labeled():    &immutableLabeledDirected{},
+		Spec().Immutable().DataEdges():             &immutableDataUndirected{},
+		Spec().Immutable().Directed().DataEdges():  &immutableDataDirected{},
+		Spec().Immutable().Directed().Basic():      &immutableDirected{},
+		Spec().Immutable().Undirected().Weighted(): &immutableWeightedUndirected{},
+	}
+	for gs, expected := range specs {
+		c.Assert(gs.Create(G), FitsTypeOf, expected)
+	}
+}
+
+func (s *ImmutableSuite) TestTransposeKeepsSize(c *C) {
+	g := Spec().Immutable().Directed().Weighted().Using(WeightedArcList{
+		NewWeightedArc("foo", "bar", 1.5),
+		NewWeightedArc("bar", "baz", 2),
+	}).Create(G).(WeightedDigraph)
+
+	tg := g.Transpose().(WeightedDigraph)
+	c.Assert(Size(tg), Equals, 2)
+	c.Assert(Order(tg), Equals, 3)
+	c.Assert(tg.HasWeightedArc(NewWeightedArc("bar", "foo", 1.5)), Equals, true)
+
+	c.Assert(Size(Spec().Immutable().Directed().Weighted().Create(G).(Digraph).Transpose()), Equals, 0)
+}
+
+// Run with -race to verify that concurrent reads need no synchronization.
+func (s *ImmutableSuite) TestConcurrentReads(c *C) {
+	var el LabeledEdgeList
+	for i := 0; i < 100; i++ {
+		el = append(el, NewLabeledEdge(i, i+1, "foo"))
+	}
+	g := Spec().Immutable().Labeled().Using(el).Create(G).(LabeledGraph)
+
+	var wg sync.WaitGroup
+	counts := make([]int, 8)
+	for w := range counts {
+		wg.Add(1)
+		go func(w int) {
+			defer wg.Done()
+			g.Edges(func(e Edge) (terminate bool) {
+				if g.HasLabeledEdge(e.(LabeledEdge)) {
+					counts[w]++
+				}
+				return
+			})
+		}(w)
+	}
+	wg.Wait()
+
+	for _, n := range counts {
+		c.Assert(n, Equals, 100)
+	}
+}
@@ -557,3 +557,331 @@ func (g *labeledUndirected) RemoveEdges(edges ...LabeledEdge) {
 		}
 	}
 }
+
+/* Immutable labeled implementations */
+
+// The lock-free base for immutable labeled graphs, as with baseImmutableWeighted.
+type baseImmutableLabeled struct {
+	list map[Vertex]map[Vertex]string
+	size int
+}
+
+// Traverses the graph's vertices in random order, passing each vertex to the
+// provided closure.
+func (g *baseImmutableLabeled) Vertices(f VertexStep) {
+	for v := range g.list {
+		if f(v) {
+			return
+		}
+	}
+}
+
+// Indicates whether or not the given vertex is present in the graph.
+func (g *baseImmutableLabeled) HasVertex(vertex Vertex) bool {
+	return g.hasVertex(vertex)
+}
+
+// Indicates whether or not the given vertex is present in the graph.
+func (g *baseImmutableLabeled) hasVertex(vertex Vertex) (exists bool) {
+	_, exists = g.list[vertex]
+	return
+}
+
+// Returns the order (number of vertices) in the graph.
+func (g *baseImmutableLabeled) Order() int {
+	return len(g.list)
+}
+
+// Returns the size (number of edges) in the graph.
+func (g *baseImmutableLabeled) Size() int {
+	return g.size
+}
+
+// Adds the provided vertices to the graph. If a provided vertex is
+// already present in the graph, it is a no-op (for that vertex only).
+func (g *baseImmutableLabeled) ensureVertex(vertices ...Vertex) {
+	for _, vertex := range vertices {
+		if !g.hasVertex(vertex) {
+			g.list[vertex] = make(map[Vertex]string, 10)
+		}
+	}
+}
+
+type immutableLabeledDirected struct {
+	baseImmutableLabeled
+}
+
+// Returns the outdegree of the provided vertex. If the vertex is not present in the
+// graph, the second return value will be false.
+func (g *immutableLabeledDirected) OutDegreeOf(vertex Vertex) (degree int, exists bool) {
+	if exists = g.hasVertex(vertex); exists {
+		degree = len(g.list[vertex])
+	}
+	return
+}
+
+// Returns the indegree of the provided vertex. If the vertex is not present in the
+// graph, the second return value will be false.
+//
+// Note that getting indegree is inefficient for directed adjacency lists; it requires
+// a full scan of the graph's edge set.
+func (g *immutableLabeledDirected) InDegreeOf(vertex Vertex) (degree int, exists bool) {
+	return inDegreeOf(g, vertex)
+}
+
+// Returns the degree of the given vertex, counting both in and out-edges.
+func (g *immutableLabeledDirected) DegreeOf(vertex Vertex) (degree int, exists bool) {
+	indegree, exists := inDegreeOf(g, vertex)
+	outdegree, exists := g.OutDegreeOf(vertex)
+	return indegree + outdegree, exists
+}
+
+// Enumerates the set of all edges incident to the provided vertex.
+func (g *immutableLabeledDirected) IncidentTo(v Vertex, f EdgeStep) {
+	eachEdgeIncidentToDirected(g, v, f)
+}
+
+// Enumerates the vertices adjacent to the provided vertex.
+func (g *immutableLabeledDirected) AdjacentTo(start Vertex, f VertexStep) {
+	g.IncidentTo(start, func(e Edge) bool {
+		u, v := e.Both()
+		if u == start {
+			return f(v)
+		} else {
+			return f(u)
+		}
+	})
+}
+
+// Enumerates the set of out-edges for the provided vertex.
+func (g *immutableLabeledDirected) ArcsFrom(v Vertex, f ArcStep) {
+	if !g.hasVertex(v) {
+		return
+	}
+
+	for adjacent, label := range g.list[v] {
+		if f(NewLabeledArc(v, adjacent, label)) {
+			return
+		}
+	}
+}
+
+func (g *immutableLabeledDirected) SuccessorsOf(v Vertex, f VertexStep) {
+	eachVertexInAdjacencyList(g.list, v, f)
+}
+
+// Enumerates the set of in-edges for the provided vertex.
+func (g *immutableLabeledDirected) ArcsTo(v Vertex, f ArcStep) {
+	if !g.hasVertex(v) {
+		return
+	}
+
+	for candidate, adjacent := range g.list {
+		for target, label := range adjacent {
+			if target == v {
+				if f(NewLabeledArc(candidate, target, label)) {
+					return
+				}
+			}
+		}
+	}
+}
+
+func (g *immutableLabeledDirected) PredecessorsOf(v Vertex, f VertexStep) {
+	eachPredecessorOf(g.list, v, f)
+}
+
+// Traverses the set of edges in the graph, passing each edge to the
+// provided closure.
+func (g *immutableLabeledDirected) Edges(f EdgeStep) {
+	for source, adjacent := range g.list {
+		for target, label := range adjacent {
+			if f(NewLabeledEdge(source, target, label)) {
+				return
+			}
+		}
+	}
+}
+
+// Traverses the set of arcs in the graph, passing each arc to the
+// provided closure.
+func (g *immutableLabeledDirected) Arcs(f ArcStep) {
+	for source, adjacent := range g.list {
+		for target, label := range adjacent {
+			if f(NewLabeledArc(source, target, label)) {
+				return
+			}
+		}
+	}
+}
+
+// Indicates whether or not the given edge is present in the graph. It matches
+// based solely on the presence of an edge, disregarding edge label.
+func (g *immutableLabeledDirected) HasEdge(edge Edge) bool {
+	u, v := edge.Both()
+	_, exists := g.list[u][v]
+	if !exists {
+		_, exists = g.list[v][u]
+	}
+	return exists
+}
+
+// Indicates whether or not the given arc is present in the graph.
+func (g *immutableLabeledDirected) HasArc(arc Arc) bool {
+	_, exists := g.list[arc.Source()][arc.Target()]
+	return exists
+}
+
+// Indicates whether or not the given labeled edge is present in the graph.
+// It will only match if the provided LabeledEdge has the same label as
+// the edge contained in the graph.
+func (g *immutableLabeledDirected) HasLabeledEdge(edge LabeledEdge) bool {
+	u, v := edge.Both()
+	if label, exists := g.list[u][v]; exists {
+		return label == edge.Label()
+	} else if label, exists = g.list[v][u]; exists {
+		return label == edge.Label()
+	}
+	return false
+}
+
+// Indicates whether or not the given labeled arc is present in the graph.
+// It will only match if the provided LabeledArc has the same label as
+// the arc contained in the graph.
+func (g *immutableLabeledDirected) HasLabeledArc(arc LabeledArc) bool {
+	if label, exists := g.list[arc.Source()][arc.Target()]; exists {
+		return label == arc.Label()
+	}
+	return false
+}
+
+// Returns the density of the graph. Density is the ratio of edge count to the
+// number of edges there would be in complete graph (maximum edge count).
+func (g *immutableLabeledDirected) Density() float64 {
+	order := g.Order()
+	return float64(g.Size()) / float64(order*(order-1))
+}
+
+// Returns a graph with the same vertex and edge set, but with the
+// directionality of all its edges reversed.
+//
+// This implementation returns a new graph object (doubling memory use),
+// but not all implementations do so.
+func (g *immutableLabeledDirected) Transpose() Digraph {
+	g2 := &immutableLabeledDirected{}
+	g2.list = make(map[Vertex]map[Vertex]string, len(g.list))
+	g2.size = g.size
+
+	for source, adjacent := range g.list {
+		g2.ensureVertex(source)
+		for target, label := range adjacent {
+			g2.ensureVertex(target)
+			g2.list[target][source] = label
+		}
+	}
+
+	return g2
+}
+
+// Adds a new arc to the graph.
+func (g *immutableLabeledDirected) addArcs(arcs ...LabeledArc) {
+	for _, arc := range arcs {
+		g.ensureVertex(arc.Source(), arc.Target())
+
+		if _, exists := g.list[arc.Source()][arc.Target()]; !exists {
+			g.list[arc.Source()][arc.Target()] = arc.Label()
+			g.size++
+		}
+	}
+}
+
+type immutableLabeledUndirected struct {
+	baseImmutableLabeled
+}
+
+// Returns the degree of the provided vertex. If the vertex is not present in the
+// graph, the second return value will be false.
+func (g *immutableLabeledUndirected) DegreeOf(vertex Vertex) (degree int, exists bool) {
+	if exists = g.hasVertex(vertex); exists {
+		degree = len(g.list[vertex])
+	}
+	return
+}
+
+// Traverses the set of edges in the graph, passing each edge to the
+// provided closure.
+func (g *immutableLabeledUndirected) Edges(f EdgeStep) {
+	visited := set.NewNonTS()
+
+	var e LabeledEdge
+	for source, adjacent := range g.list {
+		for target, label := range adjacent {
+			e = NewLabeledEdge(source, target, label)
+			if !visited.Has(NewEdge(e.Both())) {
+				visited.Add(NewEdge(target, source))
+				if f(e) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// Enumerates the set of all edges incident to the provided vertex.
+func (g *immutableLabeledUndirected) IncidentTo(v Vertex, f EdgeStep) {
+	if !g.hasVertex(v) {
+		return
+	}
+
+	for adjacent, label := range g.list[v] {
+		if f(NewLabeledEdge(v, adjacent, label)) {
+			return
+		}
+	}
+}
+
+// Enumerates the vertices adjacent to the provided vertex.
+func (g *immutableLabeledUndirected) AdjacentTo(vertex Vertex, f VertexStep) {
+	eachVertexInAdjacencyList(g.list, vertex, f)
+}
+
+// Indicates whether or not the given edge is present in the graph. It matches
+// based solely on the presence of an edge, disregarding edge label.
+func (g *immutableLabeledUndirected) HasEdge(edge Edge) bool {
+	u, v := edge.Both()
+	_, exists := g.list[u][v]
+	return exists
+}
+
+// Indicates whether or not the given labeled edge is present in the graph.
+// It will only match if the provided LabeledEdge has the same label as
+// the edge contained in the graph.
+func (g *immutableLabeledUndirected) HasLabeledEdge(edge LabeledEdge) bool {
+	u, v := edge.Both()
+	if label, exists := g.list[u][v]; exists {
+		return edge.Label() == label
+	}
+	return false
+}
+
+// Returns the density of the graph. Density is the ratio of edge count to the
+// number of edges there would be in complete graph (maximum edge count).
+func (g *immutableLabeledUndirected) Density() float64 {
+	order := g.Order()
+	return 2 * float64(g.Size()) / float64(order*(order-1))
+}
+
+// Adds a new edge to the graph.
+func (g *immutableLabeledUndirected) addEdges(edges ...LabeledEdge) {
+	for _, edge := range edges {
+		u, v := edge.Both()
+		g.ensureVertex(u, v)
+
+		if _, exists := g.list[u][v]; !exists {
+			w := edge.Label()
+			g.list[u][v] = w
+			g.list[v][u] = w
+			g.size++
+		}
+	}
+}
@@ -0,0 +1,134 @@
+package al
+
+import (
+	"sync"
+
+	. "github.com/sdboyer/gogl"
+)
+
+/*
+Multigraphs and pseudographs permit parallel edges: any number of edges may
+connect the same pair of vertices, and each is a distinct member of the edge set.
+Pseudographs additionally permit loops, edges connecting a vertex to itself;
+multigraphs silently discard them.
+
+These adjacency lists hold a slice of edges for each pair of adjacent vertices,
+rather than a single entry. Edges are enumerated individually by Edges, Arcs,
+IncidentTo, ArcsFrom and ArcsTo, and are counted individually by Size and the
+degree methods; adjacent vertices, however, are enumerated only once each.
+
+Because parallel edges may be identical, removal works by count: RemoveEdges
+and RemoveArcs remove one matching edge for each edge they are passed. For basic
+edges, any edge between the same vertices (respecting direction, for digraphs)
+matches; for weighted, labeled and data edges, the weight, label or data must
+also be equal. Passing an identical edge twice removes two such edges, if present.
+*/
+
+// Initializes an empty multigraph or pseudograph of the type provided.
+func newMulti(g interface {
+	Graph
+	init(loops bool)
+}, loops bool) Graph {
+	g.init(loops)
+	return g
+}
+
+// Matchers used to select which of several parallel edges to remove, or to check for.
+// Edges stored in a typed graph are always of that type, so the assertions are safe.
+
+func anyEdge(Edge) bool {
+	return true
+}
+
+func sameWeight(weight float64) func(Edge) bool {
+	return func(e Edge) bool {
+		return e.(WeightedEdge).Weight() == weight
+	}
+}
+
+func sameLabel(label string) func(Edge) bool {
+	return func(e Edge) bool {
+		return e.(LabeledEdge).Label() == label
+	}
+}
+
+func sameData(data interface{}) func(Edge) bool {
+	return func(e Edge) bool {
+		return e.(DataEdge).Data() == data
+	}
+}
+
+// Removes the i'th of the edges held from u to v in the provided list, dropping
+// the entry for v entirely if no edges remain.
+func detach(list map[Vertex]map[Vertex][]Edge, u, v Vertex, i int) {
+	edges := list[u][v]
+	if len(edges) == 1 {
+		delete(list[u], v)
+		return
+	}
+
+	copy(edges[i:], edges[i+1:])
+	edges[len(edges)-1] = nil
+	list[u][v] = edges[:len(edges)-1]
+}
+
+// Contains the vertex set and bookkeeping shared by all multigraph implementations.
+type baseMulti struct {
+	list  map[Vertex]map[Vertex][]Edge
+	size  int
+	loops bool
+	mu    sync.RWMutex
+}
+
+// Indicates whether or not the given vertex is present in the graph.
+func (g *baseMulti) hasVertex(vertex Vertex) (exists bool) {
+	_, exists = g.list[vertex]
+	return
+}
+
+// Traverses the graph's vertices in random order, passing each vertex to the
+// provided closure.
+func (g *baseMulti) Vertices(f VertexStep) {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+
+	for v := range g.list {
+		if f(v) {
+			return
+		}
+	}
+}
+
+// Indicates whether or not the given vertex is present in the graph.
+func (g *baseMulti) HasVertex(vertex Vertex) bool {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+
+	return g.hasVertex(vertex)
+}
+
+// Returns the order (number of vertices) in the graph.
+func (g *baseMulti) Order() int {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+
+	return len(g.list)
+}
+
+// Returns the size (number of edges) in the graph. Each parallel edge is counted.
+func (g *baseMulti) Size() int {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+
+	return g.size
+}
+
+// Indicates whether any edge from u to v in the given list satisfies the matcher.
+func hasMatching(list map[Vertex]map[Vertex][]Edge, u, v Vertex, match func(Edge) bool) bool {
+	for _, e := range list[u][v] {
+		if match(e) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,696 @@
+package al
+
+import (
+	. "github.com/sdboyer/gogl"
+)
+
+// The core of a directed multigraph or pseudograph. Each arc is held in the
+// out-list of its source and the in-list of its target, in the same position in
+// both slices, so that in-arcs can be enumerated without a full scan.
+type multiDirected struct {
+	baseMulti
+	in map[Vertex]map[Vertex][]Edge
+}
+
+func (g *multiDirected) init(loops bool) {
+	g.list = make(map[Vertex]map[Vertex][]Edge)
+	g.in = make(map[Vertex]map[Vertex][]Edge)
+	g.loops = loops
+}
+
+// Adds the provided vertices to the graph. If a provided vertex is
+// already present in the graph, it is a no-op (for that vertex only).
+func (g *multiDirected) ensureVertex(vertices ...Vertex) {
+	for _, vertex := range vertices {
+		if !g.hasVertex(vertex) {
+			g.list[vertex] = make(map[Vertex][]Edge)
+			g.in[vertex] = make(map[Vertex][]Edge)
+		}
+	}
+}
+
+// Adds an arc, which must already be of the graph's arc type. Loops are discarded
+// unless the graph permits them.
+func (g *multiDirected) addArc(a Arc) {
+	s, t := a.Both()
+	if s == t && !g.loops {
+		return
+	}
+
+	g.ensureVertex(s, t)
+	g.list[s][t] = append(g.list[s][t], a)
+	g.in[t][s] = append(g.in[t][s], a)
+	g.size++
+}
+
+// Removes the first arc from the source to the target of the provided arc that
+// satisfies the matcher, if any.
+func (g *multiDirected) removeArc(a Arc, match func(Edge) bool) {
+	s, t := a.Both()
+	for i, candidate := range g.list[s][t] {
+		if match(candidate) {
+			detach(g.list, s, t, i)
+			detach(g.in, t, s, i)
+			g.size--
+			return
+		}
+	}
+}
+
+// Removes a vertex, along with all its incident arcs.
+func (g *multiDirected) removeVertex(vertex Vertex) {
+	for target, arcs := range g.list[vertex] {
+		g.size -= len(arcs)
+		delete(g.in[target], vertex)
+	}
+	for source, arcs := range g.in[vertex] {
+		// Loops were already counted among the out-arcs.
+		if source != vertex {
+			g.size -= len(arcs)
+			delete(g.list[source], vertex)
+		}
+	}
+	delete(g.list, vertex)
+	delete(g.in, vertex)
+}
+
+// Indicates whether an arc in either direction between the vertices of the
+// provided edge satisfies the matcher.
+func (g *multiDirected) hasMatchingEdge(e Edge, match func(Edge) bool) bool {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+
+	u, v := e.Both()
+	return hasMatching(g.list, u, v, match) || hasMatching(g.list, v, u, match)
+}
+
+// Indicates whether an arc from the source to the target of the provided arc
+// satisfies the matcher.
+func (g *multiDirected) hasMatchingArc(a Arc, match func(Edge) bool) bool {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+
+	return hasMatching(g.list, a.Source(), a.Target(), match)
+}
+
+// Populates the provided graph with this graph's vertices, and its arcs as
+// reversed by the provided function.
+func (g *multiDirected) transposeInto(g2 *multiDirected, reverse func(Arc) Arc) {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+
+	g2.init(g.loops)
+	for vertex, adjacent := range g.list {
+		g2.ensureVertex(vertex)
+		for _, arcs := range adjacent {
+			for _, a := range arcs {
+				g2.addArc(reverse(a.(Arc)))
+			}
+		}
+	}
+}
+
+// Returns the number of arcs held in the given list for the provided vertex.
+func countArcs(list map[Vertex]map[Vertex][]Edge, vertex Vertex) (degree int) {
+	for _, arcs := range list[vertex] {
+		degree += len(arcs)
+	}
+	return
+}
+
+// Returns the outdegree of the provided vertex, counting each parallel arc. If the
+// vertex is not present in the graph, the second return value will be false.
+func (g *multiDirected) OutDegreeOf(vertex Vertex) (degree int, exists bool) {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+
+	if exists = g.hasVertex(vertex); exists {
+		degree = countArcs(g.list, vertex)
+	}
+	return
+}
+
+// Returns the indegree of the provided vertex, counting each parallel arc. If the
+// vertex is not present in the graph, the second return value will be false.
+func (g *multiDirected) InDegreeOf(vertex Vertex) (degree int, exists bool) {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+
+	if exists = g.hasVertex(vertex); exists {
+		degree = countArcs(g.in, vertex)
+	}
+	return
+}
+
+// Returns the degree of the provided vertex, counting both in and out-arcs. A loop
+// is thus counted twice.
+func (g *multiDirected) DegreeOf(vertex Vertex) (degree int, exists bool) {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+
+	if exists = g.hasVertex(vertex); exists {
+		degree = countArcs(g.list, vertex) + countArcs(g.in, vertex)
+	}
+	return
+}
+
+// Traverses the set of edges in the graph, passing each edge, including each
+// parallel edge, to the provided closure.
+func (g *multiDirected) Edges(f EdgeStep) {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+
+	for _, adjacent := range g.list {
+		for _, arcs := range adjacent {
+			for _, a := range arcs {
+				if f(a) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// Traverses the set of arcs in the graph, passing each arc, including each
+// parallel arc, to the provided closure.
+func (g *multiDirected) Arcs(f ArcStep) {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+
+	for _, adjacent := range g.list {
+		for _, arcs := range adjacent {
+			for _, a := range arcs {
+				if f(a.(Arc)) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// Enumerates the set of all edges incident to the provided vertex, including
+// each parallel edge. A loop is passed only once.
+func (g *multiDirected) IncidentTo(v Vertex, f EdgeStep) {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+
+	for _, arcs := range g.list[v] {
+		for _, a := range arcs {
+			if f(a) {
+				return
+			}
+		}
+	}
+	for source, arcs := range g.in[v] {
+		if source == v {
+			continue
+		}
+		for _, a := range arcs {
+			if f(a) {
+				return
+			}
+		}
+	}
+}
+
+// Enumerates the vertices adjacent to the provided vertex. Each adjacent vertex is
+// passed once, regardless of how many arcs connect it, or in which direction.
+func (g *multiDirected) AdjacentTo(start Vertex, f VertexStep) {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+
+	for adjacent := range g.list[start] {
+		if f(adjacent) {
+			return
+		}
+	}
+	for adjacent := range g.in[start] {
+		if _, seen := g.list[start][adjacent]; !seen {
+			if f(adjacent) {
+				return
+			}
+		}
+	}
+}
+
+// Enumerates the set of out-arcs for the provided vertex, including each
+// parallel arc.
+func (g *multiDirected) ArcsFrom(v Vertex, f ArcStep) {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+
+	for _, arcs := range g.list[v] {
+		for _, a := range arcs {
+			if f(a.(Arc)) {
+				return
+			}
+		}
+	}
+}
+
+// Enumerates the set of in-arcs for the provided vertex, including each
+// parallel arc.
+func (g *multiDirected) ArcsTo(v Vertex, f ArcStep) {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+
+	for _, arcs := range g.in[v] {
+		for _, a := range arcs {
+			if f(a.(Arc)) {
+				return
+			}
+		}
+	}
+}
+
+// Enumerates the successors of the provided vertex, each only once.
+func (g *multiDirected) SuccessorsOf(v Vertex, f VertexStep) {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+
+	for successor := range g.list[v] {
+		if f(successor) {
+			return
+		}
+	}
+}
+
+// Enumerates the predecessors of the provided vertex, each only once.
+func (g *multiDirected) PredecessorsOf(v Vertex, f VertexStep) {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+
+	for predecessor := range g.in[v] {
+		if f(predecessor) {
+			return
+		}
+	}
+}
+
+// Indicates whether or not any arc, in either direction, connects the vertices
+// of the given edge.
+func (g *multiDirected) HasEdge(edge Edge) bool {
+	return g.hasMatchingEdge(edge, anyEdge)
+}
+
+// Indicates whether or not any arc connects the source and target of the given arc.
+func (g *multiDirected) HasArc(arc Arc) bool {
+	return g.hasMatchingArc(arc, anyEdge)
+}
+
+// The core of a mutable directed multigraph, adding vertex mutation.
+type multiDirectedMut struct {
+	multiDirected
+}
+
+// Adds the provided vertices to the graph. If a provided vertex is
+// already present in the graph, it is a no-op (for that vertex only).
+func (g *multiDirectedMut) EnsureVertex(vertices ...Vertex) {
+	if len(vertices) == 0 {
+		return
+	}
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	g.ensureVertex(vertices...)
+}
+
+// Removes a vertex from the graph. Also removes any arcs of which that
+// vertex is a member.
+func (g *multiDirectedMut) RemoveVertex(vertices ...Vertex) {
+	if len(vertices) == 0 {
+		return
+	}
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	for _, vertex := range vertices {
+		g.removeVertex(vertex)
+	}
+}
+
+/* Basic arcs */
+
+func reverseArc(a Arc) Arc {
+	return NewArc(a.Target(), a.Source())
+}
+
+type immutableMultiDirected struct {
+	multiDirected
+}
+
+// Returns a graph with the same vertex and arc set, but with the
+// directionality of all its arcs reversed.
+func (g *immutableMultiDirected) Transpose() Digraph {
+	g2 := &immutableMultiDirected{}
+	g.transposeInto(&g2.multiDirected, reverseArc)
+	return g2
+}
+
+// Adds new arcs to the graph.
+func (g *immutableMultiDirected) addArcs(arcs ...Arc) {
+	for _, arc := range arcs {
+		g.addArc(NewArc(arc.Both()))
+	}
+}
+
+type mutableMultiDirected struct {
+	multiDirectedMut
+}
+
+// Returns a graph with the same vertex and arc set, but with the
+// directionality of all its arcs reversed.
+func (g *mutableMultiDirected) Transpose() Digraph {
+	g2 := &mutableMultiDirected{}
+	g.transposeInto(&g2.multiDirected, reverseArc)
+	return g2
+}
+
+// Adds arcs to the graph. Each provided arc is added as a new arc, even if an
+// identical arc is already present.
+func (g *mutableMultiDirected) AddArcs(arcs ...Arc) {
+	if len(arcs) == 0 {
+		return
+	}
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	g.addArcs(arcs...)
+}
+
+// Adds new arcs to the graph.
+func (g *mutableMultiDirected) addArcs(arcs ...Arc) {
+	for _, arc := range arcs {
+		g.addArc(NewArc(arc.Both()))
+	}
+}
+
+// Removes arcs from the graph. For each provided arc, one arc with the same
+// source and target is removed. This does NOT remove vertex members of the
+// removed arcs.
+func (g *mutableMultiDirected) RemoveArcs(arcs ...Arc) {
+	if len(arcs) == 0 {
+		return
+	}
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	for _, arc := range arcs {
+		g.removeArc(arc, anyEdge)
+	}
+}
+
+/* Weighted arcs */
+
+func reverseWeightedArc(a Arc) Arc {
+	return NewWeightedArc(a.Target(), a.Source(), a.(WeightedArc).Weight())
+}
+
+type immutableWeightedMultiDirected struct {
+	multiDirected
+}
+
+// Indicates whether or not any arc, in either direction, connecting the vertices
+// of the given edge has the same weight.
+func (g *immutableWeightedMultiDirected) HasWeightedEdge(edge WeightedEdge) bool {
+	return g.hasMatchingEdge(edge, sameWeight(edge.Weight()))
+}
+
+// Indicates whether or not any arc connecting the source and target of the given
+// arc has the same weight.
+func (g *immutableWeightedMultiDirected) HasWeightedArc(arc WeightedArc) bool {
+	return g.hasMatchingArc(arc, sameWeight(arc.Weight()))
+}
+
+// Returns a graph with the same vertex and arc set, but with the
+// directionality of all its arcs reversed.
+func (g *immutableWeightedMultiDirected) Transpose() Digraph {
+	g2 := &immutableWeightedMultiDirected{}
+	g.transposeInto(&g2.multiDirected, reverseWeightedArc)
+	return g2
+}
+
+// Adds new arcs to the graph.
+func (g *immutableWeightedMultiDirected) addArcs(arcs ...WeightedArc) {
+	for _, arc := range arcs {
+		g.addArc(NewWeightedArc(arc.Source(), arc.Target(), arc.Weight()))
+	}
+}
+
+type weightedMultiDirected struct {
+	multiDirectedMut
+}
+
+// Indicates whether or not any arc, in either direction, connecting the vertices
+// of the given edge has the same weight.
+func (g *weightedMultiDirected) HasWeightedEdge(edge WeightedEdge) bool {
+	return g.hasMatchingEdge(edge, sameWeight(edge.Weight()))
+}
+
+// Indicates whether or not any arc connecting the source and target of the given
+// arc has the same weight.
+func (g *weightedMultiDirected) HasWeightedArc(arc WeightedArc) bool {
+	return g.hasMatchingArc(arc, sameWeight(arc.Weight()))
+}
+
+// Returns a graph with the same vertex and arc set, but with the
+// directionality of all its arcs reversed.
+func (g *weightedMultiDirected) Transpose() Digraph {
+	g2 := &weightedMultiDirected{}
+	g.transposeInto(&g2.multiDirected, reverseWeightedArc)
+	return g2
+}
+
+// Adds arcs to the graph. Each provided arc is added as a new arc, even if an
+// identical arc is already present.
+func (g *weightedMultiDirected) AddArcs(arcs ...WeightedArc) {
+	if len(arcs) == 0 {
+		return
+	}
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	g.addArcs(arcs...)
+}
+
+// Adds new arcs to the graph.
+func (g *weightedMultiDirected) addArcs(arcs ...WeightedArc) {
+	for _, arc := range arcs {
+		g.addArc(NewWeightedArc(arc.Source(), arc.Target(), arc.Weight()))
+	}
+}
+
+// Removes arcs from the graph. For each provided arc, one arc with the same
+// source, target and weight is removed. This does NOT remove vertex members
+// of the removed arcs.
+func (g *weightedMultiDirected) RemoveArcs(arcs ...WeightedArc) {
+	if len(arcs) == 0 {
+		return
+	}
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	for _, arc := range arcs {
+		g.removeArc(arc, sameWeight(arc.Weight()))
+	}
+}
+
+/* Labeled arcs */
+
+func reverseLabeledArc(a Arc) Arc {
+	return NewLabeledArc(a.Target(), a.Source(), a.(LabeledArc).Label())
+}
+
+type immutableLabeledMultiDirected struct {
+	multiDirected
+}
+
+// Indicates whether or not any arc, in either direction, connecting the vertices
+// of the given edge has the same label.
+func (g *immutableLabeledMultiDirected) HasLabeledEdge(edge LabeledEdge) bool {
+	return g.hasMatchingEdge(edge, sameLabel(edge.Label()))
+}
+
+// Indicates whether or not any arc connecting the source and target of the given
+// arc has the same label.
+func (g *immutableLabeledMultiDirected) HasLabeledArc(arc LabeledArc) bool {
+	return g.hasMatchingArc(arc, sameLabel(arc.Label()))
+}
+
+// Returns a graph with the same vertex and arc set, but with the
+// directionality of all its arcs reversed.
+func (g *immutableLabeledMultiDirected) Transpose() Digraph {
+	g2 := &immutableLabeledMultiDirected{}
+	g.transposeInto(&g2.multiDirected, reverseLabeledArc)
+	return g2
+}
+
+// Adds new arcs to the graph.
+func (g *immutableLabeledMultiDirected) addArcs(arcs ...LabeledArc) {
+	for _, arc := range arcs {
+		g.addArc(NewLabeledArc(arc.Source(), arc.Target(), arc.Label()))
+	}
+}
+
+type labeledMultiDirected struct {
+	multiDirectedMut
+}
+
+// Indicates whether or not any arc, in either direction, connecting the vertices
+// of the given edge has the same label.
+func (g *labeledMultiDirected) HasLabeledEdge(edge LabeledEdge) bool {
+	return g.hasMatchingEdge(edge, sameLabel(edge.Label()))
+}
+
+// Indicates whether or not any arc connecting the source and target of the given
+// arc has the same label.
+func (g *labeledMultiDirected) HasLabeledArc(arc LabeledArc) bool {
+	return g.hasMatchingArc(arc, sameLabel(arc.Label()))
+}
+
+// Returns a graph with the same vertex and arc set, but with the
+// directionality of all its arcs reversed.
+func (g *labeledMultiDirected) Transpose() Digraph {
+	g2 := &labeledMultiDirected{}
+	g.transposeInto(&g2.multiDirected, reverseLabeledArc)
+	return g2
+}
+
+// Adds arcs to the graph. Each provided arc is added as a new arc, even if an
+// identical arc is already present.
+func (g *labeledMultiDirected) AddArcs(arcs ...LabeledArc) {
+	if len(arcs) == 0 {
+		return
+	}
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	g.addArcs(arcs...)
+}
+
+// Adds new arcs to the graph.
+func (g *labeledMultiDirected) addArcs(arcs ...LabeledArc) {
+	for _, arc := range arcs {
+		g.addArc(NewLabeledArc(arc.Source(), arc.Target(), arc.Label()))
+	}
+}
+
+// Removes arcs from the graph. For each provided arc, one arc with the same
+// source, target and label is removed. This does NOT remove vertex members
+// of the removed arcs.
+func (g *labeledMultiDirected) RemoveArcs(arcs ...LabeledArc) {
+	if len(arcs) == 0 {
+		return
+	}
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	for _, arc := range arcs {
+		g.removeArc(arc, sameLabel(arc.Label()))
+	}
+}
+
+/* Data arcs */
+
+func reverseDataArc(a Arc) Arc {
+	return NewDataArc(a.Target(), a.Source(), a.(DataArc).Data())
+}
+
+type immutableDataMultiDirected struct {
+	multiDirected
+}
+
+// Indicates whether or not any arc, in either direction, connecting the vertices
+// of the given edge carries equal data.
+func (g *immutableDataMultiDirected) HasDataEdge(edge DataEdge) bool {
+	return g.hasMatchingEdge(edge, sameData(edge.Data()))
+}
+
+// Indicates whether or not any arc connecting the source and target of the given
+// arc carries equal data.
+func (g *immutableDataMultiDirected) HasDataArc(arc DataArc) bool {
+	return g.hasMatchingArc(arc, sameData(arc.Data()))
+}
+
+// Returns a graph with the same vertex and arc set, but with the
+// directionality of all its arcs reversed.
+func (g *immutableDataMultiDirected) Transpose() Digraph {
+	g2 := &immutableDataMultiDirected{}
+	g.transposeInto(&g2.multiDirected, reverseDataArc)
+	return g2
+}
+
+// Adds new arcs to the graph.
+func (g *immutableDataMultiDirected) addArcs(arcs ...DataArc) {
+	for _, arc := range arcs {
+		g.addArc(NewDataArc(arc.Source(), arc.Target(), arc.Data()))
+	}
+}
+
+type dataMultiDirected struct {
+	multiDirectedMut
+}
+
+// Indicates whether or not any arc, in either direction, connecting the vertices
+// of the given edge carries equal data.
+func (g *dataMultiDirected) HasDataEdge(edge DataEdge) bool {
+	return g.hasMatchingEdge(edge, sameData(edge.Data()))
+}
+
+// Indicates whether or not any arc connecting the source and target of the given
+// arc carries equal data.
+func (g *dataMultiDirected) HasDataArc(arc DataArc) bool {
+	return g.hasMatchingArc(arc, sameData(arc.Data()))
+}
+
+// Returns a graph with the same vertex and arc set, but with the
+// directionality of all its arcs reversed.
+func (g *dataMultiDirected) Transpose() Digraph {
+	g2 := &dataMultiDirected{}
+	g.transposeInto(&g2.multiDirected, reverseDataArc)
+	return g2
+}
+
+// Adds arcs to the graph. Each provided arc is added as a new arc, even if an
+// identical arc is already present.
+func (g *dataMultiDirected) AddArcs(arcs ...DataArc) {
+	if len(arcs) == 0 {
+		return
+	}
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	g.addArcs(arcs...)
+}
+
+// Adds new arcs to the graph.
+func (g *dataMultiDirected) addArcs(arcs ...DataArc) {
+	for _, arc := range arcs {
+		g.addArc(NewDataArc(arc.Source(), arc.Target(), arc.Data()))
+	}
+}
+
+// Removes arcs from the graph. For each provided arc, one arc with the same
+// source, target and data is removed. This does NOT remove vertex members
+// of the removed arcs.
+func (g *dataMultiDirected) RemoveArcs(arcs ...DataArc) {
+	if len(arcs) == 0 {
+		return
+	}
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	for _, arc := range arcs {
+		g.removeArc(arc, sameData(arc.Data()))
+	}
+}
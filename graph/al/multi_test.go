@@ -0,0 +1,266 @@
+package al
+
+import (
+	. "github.com/sdboyer/gocheck"
+	. "github.com/sdboyer/gogl"
+)
+
+type MultiGraphSuite struct{}
+
+var _ = Suite(&MultiGraphSuite{})
+
+func (s *MultiGraphSuite) TestCreatorSelection(c *C) {
+	_, ok := Spec().MultiGraph().Create(G).(*mutableMultiUndirected)
+	c.Assert(ok, Equals, true)
+
+	g := Spec().MultiGraph().Create(G).(*mutableMultiUndirected)
+	c.Assert(g.loops, Equals, false)
+
+	g = Spec().PseudoGraph().Create(G).(*mutableMultiUndirected)
+	c.Assert(g.loops, Equals, true)
+
+	// asking only for loops yields a pseudograph
+	g = Spec().Loop().Create(G).(*mutableMultiUndirected)
+	c.Assert(g.loops, Equals, true)
+
+	_, ok = Spec().Directed().Parallel().Weighted().Immutable().Create(G).(*immutableWeightedMultiDirected)
+	c.Assert(ok, Equals, true)
+
+	// simple graphs are unaffected
+	_, ok = Spec().Create(G).(*mutableUndirected)
+	c.Assert(ok, Equals, true)
+}
+
+func (s *MultiGraphSuite) TestParallelEdges(c *C) {
+	g := Spec().MultiGraph().Create(G).(MutableGraph)
+	g.AddEdges(NewEdge(1, 2), NewEdge(2, 1), NewEdge(1, 2), NewEdge(2, 3))
+
+	c.Assert(Size(g), Equals, 4)
+
+	var hit int
+	g.Edges(func(e Edge) (terminate bool) {
+		hit++
+		return
+	})
+	c.Assert(hit, Equals, 4)
+
+	hit = 0
+	g.IncidentTo(1, func(e Edge) (terminate bool) {
+		hit++
+		return
+	})
+	c.Assert(hit, Equals, 3)
+
+	// adjacent vertices are only passed once
+	hit = 0
+	g.AdjacentTo(1, func(v Vertex) (terminate bool) {
+		c.Assert(v, Equals, 2)
+		hit++
+		return
+	})
+	c.Assert(hit, Equals, 1)
+
+	degree, _ := g.DegreeOf(2)
+	c.Assert(degree, Equals, 4)
+
+	// removal takes one matching edge per edge provided, in either orientation
+	g.RemoveEdges(NewEdge(2, 1))
+	c.Assert(Size(g), Equals, 3)
+	c.Assert(g.HasEdge(NewEdge(1, 2)), Equals, true)
+
+	g.RemoveEdges(NewEdge(1, 2), NewEdge(1, 2))
+	c.Assert(Size(g), Equals, 1)
+	c.Assert(g.HasEdge(NewEdge(1, 2)), Equals, false)
+
+	hit = 0
+	g.AdjacentTo(1, func(v Vertex) (terminate bool) {
+		hit++
+		return
+	})
+	c.Assert(hit, Equals, 0)
+
+	// removing an absent edge is a no-op
+	g.RemoveEdges(NewEdge(1, 2))
+	c.Assert(Size(g), Equals, 1)
+	c.Assert(Order(g), Equals, 3)
+}
+
+func (s *MultiGraphSuite) TestLoops(c *C) {
+	g := Spec().MultiGraph().Create(G).(MutableGraph)
+	g.AddEdges(NewEdge(1, 1), NewEdge(1, 2))
+	c.Assert(Size(g), Equals, 1)
+	c.Assert(g.HasEdge(NewEdge(1, 1)), Equals, false)
+
+	g = Spec().PseudoGraph().Create(G).(MutableGraph)
+	g.AddEdges(NewEdge(1, 1), NewEdge(1, 1), NewEdge(1, 2))
+	c.Assert(Size(g), Equals, 3)
+	c.Assert(g.HasEdge(NewEdge(1, 1)), Equals, true)
+
+	// each loop is enumerated once, but counts twice toward degree
+	var hit int
+	g.Edges(func(e Edge) (terminate bool) {
+		hit++
+		return
+	})
+	c.Assert(hit, Equals, 3)
+
+	hit = 0
+	g.IncidentTo(1, func(e Edge) (terminate bool) {
+		hit++
+		return
+	})
+	c.Assert(hit, Equals, 3)
+
+	degree, _ := g.DegreeOf(1)
+	c.Assert(degree, Equals, 5)
+
+	g.RemoveEdges(NewEdge(1, 1))
+	c.Assert(Size(g), Equals, 2)
+
+	g.RemoveVertex(1)
+	c.Assert(Size(g), Equals, 0)
+	c.Assert(Order(g), Equals, 1)
+	degree, _ = g.DegreeOf(2)
+	c.Assert(degree, Equals, 0)
+}
+
+func (s *MultiGraphSuite) TestWeightedParallelEdges(c *C) {
+	g := Spec().MultiGraph().Weighted().Create(G).(MutableWeightedGraph)
+	g.AddEdges(NewWeightedEdge(1, 2, 3), NewWeightedEdge(1, 2, 5), NewWeightedEdge(2, 1, 5))
+
+	c.Assert(Size(g), Equals, 3)
+	c.Assert(g.HasWeightedEdge(NewWeightedEdge(2, 1, 3)), Equals, true)
+	c.Assert(g.HasWeightedEdge(NewWeightedEdge(1, 2, 4)), Equals, false)
+
+	var total float64
+	g.Edges(func(e Edge) (terminate bool) {
+		total += e.(WeightedEdge).Weight()
+		return
+	})
+	c.Assert(total, Equals, float64(13))
+
+	// only an edge with the same weight is removed
+	g.RemoveEdges(NewWeightedEdge(1, 2, 4))
+	c.Assert(Size(g), Equals, 3)
+
+	g.RemoveEdges(NewWeightedEdge(1, 2, 5))
+	c.Assert(Size(g), Equals, 2)
+	c.Assert(g.HasWeightedEdge(NewWeightedEdge(1, 2, 5)), Equals, true)
+
+	g.RemoveEdges(NewWeightedEdge(1, 2, 5))
+	c.Assert(g.HasWeightedEdge(NewWeightedEdge(1, 2, 5)), Equals, false)
+	c.Assert(g.HasWeightedEdge(NewWeightedEdge(1, 2, 3)), Equals, true)
+}
+
+func (s *MultiGraphSuite) TestLabeledAndDataParallelEdges(c *C) {
+	lg := Spec().MultiGraph().Labeled().Create(G).(MutableLabeledGraph)
+	lg.AddEdges(NewLabeledEdge(1, 2, "foo"), NewLabeledEdge(1, 2, "bar"))
+	c.Assert(Size(lg), Equals, 2)
+
+	lg.RemoveEdges(NewLabeledEdge(2, 1, "foo"))
+	c.Assert(lg.HasLabeledEdge(NewLabeledEdge(1, 2, "foo")), Equals, false)
+	c.Assert(lg.HasLabeledEdge(NewLabeledEdge(1, 2, "bar")), Equals, true)
+
+	dg := Spec().PseudoGraph().DataEdges().Create(G).(MutableDataGraph)
+	dg.AddEdges(NewDataEdge(1, 1, "foo"), NewDataEdge(1, 1, 42))
+	c.Assert(Size(dg), Equals, 2)
+
+	dg.RemoveEdges(NewDataEdge(1, 1, 42))
+	c.Assert(dg.HasDataEdge(NewDataEdge(1, 1, 42)), Equals, false)
+	c.Assert(dg.HasDataEdge(NewDataEdge(1, 1, "foo")), Equals, true)
+}
+
+func (s *MultiGraphSuite) TestParallelArcs(c *C) {
+	g := Spec().Directed().PseudoGraph().Create(G)
+	m := g.(ArcSetMutator)
+	dg := g.(Digraph)
+
+	m.AddArcs(NewArc(1, 2), NewArc(1, 2), NewArc(2, 1), NewArc(2, 2))
+	c.Assert(Size(g), Equals, 4)
+
+	var hit int
+	dg.ArcsFrom(1, func(a Arc) (terminate bool) {
+		c.Assert(a.Target(), Equals, 2)
+		hit++
+		return
+	})
+	c.Assert(hit, Equals, 2)
+
+	hit = 0
+	dg.ArcsTo(2, func(a Arc) (terminate bool) {
+		hit++
+		return
+	})
+	c.Assert(hit, Equals, 3)
+
+	// the loop is passed once
+	hit = 0
+	dg.IncidentTo(2, func(e Edge) (terminate bool) {
+		hit++
+		return
+	})
+	c.Assert(hit, Equals, 4)
+
+	hit = 0
+	dg.AdjacentTo(1, func(v Vertex) (terminate bool) {
+		hit++
+		return
+	})
+	c.Assert(hit, Equals, 1)
+
+	in, _ := dg.InDegreeOf(2)
+	out, _ := dg.OutDegreeOf(2)
+	degree, _ := dg.DegreeOf(2)
+	c.Assert(in, Equals, 3)
+	c.Assert(out, Equals, 2)
+	c.Assert(degree, Equals, 5)
+
+	// direction is respected on removal
+	m.RemoveArcs(NewArc(1, 2))
+	c.Assert(Size(g), Equals, 3)
+	c.Assert(dg.HasArc(NewArc(1, 2)), Equals, true)
+	c.Assert(dg.HasArc(NewArc(2, 1)), Equals, true)
+
+	g.(VertexSetMutator).RemoveVertex(2)
+	c.Assert(Size(g), Equals, 0)
+	c.Assert(Order(g), Equals, 1)
+}
+
+func (s *MultiGraphSuite) TestWeightedParallelArcs(c *C) {
+	g := Spec().Directed().MultiGraph().Weighted().Create(G)
+	m := g.(WeightedArcSetMutator)
+	dg := g.(WeightedDigraph)
+
+	m.AddArcs(NewWeightedArc(1, 2, 3), NewWeightedArc(1, 2, 5), NewWeightedArc(1, 1, 7))
+	c.Assert(Size(g), Equals, 2)
+	c.Assert(dg.HasWeightedArc(NewWeightedArc(1, 2, 5)), Equals, true)
+	c.Assert(dg.HasWeightedArc(NewWeightedArc(2, 1, 5)), Equals, false)
+	c.Assert(dg.HasWeightedEdge(NewWeightedEdge(2, 1, 5)), Equals, true)
+
+	// transposition keeps every parallel arc, along with its weight
+	tg := dg.Transpose().(WeightedDigraph)
+	c.Assert(Size(tg), Equals, 2)
+	c.Assert(tg.HasWeightedArc(NewWeightedArc(2, 1, 3)), Equals, true)
+	c.Assert(tg.HasWeightedArc(NewWeightedArc(2, 1, 5)), Equals, true)
+	c.Assert(tg.HasArc(NewArc(1, 2)), Equals, false)
+
+	m.RemoveArcs(NewWeightedArc(1, 2, 3))
+	c.Assert(dg.HasWeightedArc(NewWeightedArc(1, 2, 3)), Equals, false)
+	c.Assert(dg.HasWeightedArc(NewWeightedArc(1, 2, 5)), Equals, true)
+	c.Assert(Size(tg), Equals, 2)
+}
+
+func (s *MultiGraphSuite) TestImportKeepsParallelEdges(c *C) {
+	el := ArcList{
+		NewArc("foo", "bar"),
+		NewArc("foo", "bar"),
+		NewArc("bar", "bar"),
+	}
+
+	c.Assert(Size(Spec().Immutable().MultiGraph().Using(el).Create(G)), Equals, 2)
+	c.Assert(Size(Spec().Immutable().PseudoGraph().Using(el).Create(G)), Equals, 3)
+	c.Assert(Size(Spec().Directed().PseudoGraph().Using(el).Create(G)), Equals, 3)
+
+	// a simple graph collapses them
+	c.Assert(Size(Spec().Directed().Using(el).Create(G)), Equals, 2)
+}
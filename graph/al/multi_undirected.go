@@ -0,0 +1,441 @@
+package al
+
+import (
+	. "github.com/sdboyer/gogl"
+)
+
+// The core of an undirected multigraph or pseudograph. Each edge is held at both of
+// its ends, in the same position in both slices; a loop is held only once.
+type multiUndirected struct {
+	baseMulti
+}
+
+func (g *multiUndirected) init(loops bool) {
+	g.list = make(map[Vertex]map[Vertex][]Edge)
+	g.loops = loops
+}
+
+// Adds the provided vertices to the graph. If a provided vertex is
+// already present in the graph, it is a no-op (for that vertex only).
+func (g *multiUndirected) ensureVertex(vertices ...Vertex) {
+	for _, vertex := range vertices {
+		if !g.hasVertex(vertex) {
+			g.list[vertex] = make(map[Vertex][]Edge)
+		}
+	}
+}
+
+// Adds an edge, which must already be of the graph's edge type. Loops are discarded
+// unless the graph permits them.
+func (g *multiUndirected) addEdge(e Edge) {
+	u, v := e.Both()
+	if u == v && !g.loops {
+		return
+	}
+
+	g.ensureVertex(u, v)
+	g.list[u][v] = append(g.list[u][v], e)
+	if u != v {
+		g.list[v][u] = append(g.list[v][u], e)
+	}
+	g.size++
+}
+
+// Removes the first edge between the endpoints of the provided edge that satisfies
+// the matcher, if any.
+func (g *multiUndirected) removeEdge(e Edge, match func(Edge) bool) {
+	u, v := e.Both()
+	for i, candidate := range g.list[u][v] {
+		if match(candidate) {
+			detach(g.list, u, v, i)
+			if u != v {
+				detach(g.list, v, u, i)
+			}
+			g.size--
+			return
+		}
+	}
+}
+
+// Removes a vertex, along with all its incident edges.
+func (g *multiUndirected) removeVertex(vertex Vertex) {
+	for adjacent, edges := range g.list[vertex] {
+		g.size -= len(edges)
+		if adjacent != vertex {
+			delete(g.list[adjacent], vertex)
+		}
+	}
+	delete(g.list, vertex)
+}
+
+// Indicates whether an edge between the endpoints of the provided edge satisfies
+// the matcher.
+func (g *multiUndirected) hasMatching(e Edge, match func(Edge) bool) bool {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+
+	u, v := e.Both()
+	return hasMatching(g.list, u, v, match)
+}
+
+// Returns the degree of the provided vertex, counting each parallel edge, and each
+// loop twice. If the vertex is not present in the graph, the second return value
+// will be false.
+func (g *multiUndirected) DegreeOf(vertex Vertex) (degree int, exists bool) {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+
+	if exists = g.hasVertex(vertex); exists {
+		for adjacent, edges := range g.list[vertex] {
+			if adjacent == vertex {
+				degree += 2 * len(edges)
+			} else {
+				degree += len(edges)
+			}
+		}
+	}
+	return
+}
+
+// Traverses the set of edges in the graph, passing each edge, including each
+// parallel edge, to the provided closure.
+func (g *multiUndirected) Edges(f EdgeStep) {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+
+	// Each pair is visited from both ends; only pass edges on the first visit.
+	visited := make(map[Vertex]struct{}, len(g.list))
+	for source, adjacent := range g.list {
+		for target, edges := range adjacent {
+			if _, seen := visited[target]; seen {
+				continue
+			}
+			for _, e := range edges {
+				if f(e) {
+					return
+				}
+			}
+		}
+		visited[source] = keyExists
+	}
+}
+
+// Enumerates the set of all edges incident to the provided vertex, including
+// each parallel edge.
+func (g *multiUndirected) IncidentTo(v Vertex, f EdgeStep) {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+
+	for _, edges := range g.list[v] {
+		for _, e := range edges {
+			if f(e) {
+				return
+			}
+		}
+	}
+}
+
+// Enumerates the vertices adjacent to the provided vertex. Each adjacent vertex is
+// passed once, regardless of how many edges connect it; a vertex with a loop is
+// adjacent to itself.
+func (g *multiUndirected) AdjacentTo(vertex Vertex, f VertexStep) {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+
+	for adjacent := range g.list[vertex] {
+		if f(adjacent) {
+			return
+		}
+	}
+}
+
+// Indicates whether or not any edge connects the vertices of the given edge.
+func (g *multiUndirected) HasEdge(edge Edge) bool {
+	return g.hasMatching(edge, anyEdge)
+}
+
+// The core of a mutable undirected multigraph, adding vertex mutation.
+type multiUndirectedMut struct {
+	multiUndirected
+}
+
+// Adds the provided vertices to the graph. If a provided vertex is
+// already present in the graph, it is a no-op (for that vertex only).
+func (g *multiUndirectedMut) EnsureVertex(vertices ...Vertex) {
+	if len(vertices) == 0 {
+		return
+	}
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	g.ensureVertex(vertices...)
+}
+
+// Removes a vertex from the graph. Also removes any edges of which that
+// vertex is a member.
+func (g *multiUndirectedMut) RemoveVertex(vertices ...Vertex) {
+	if len(vertices) == 0 {
+		return
+	}
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	for _, vertex := range vertices {
+		g.removeVertex(vertex)
+	}
+}
+
+/* Basic edges */
+
+type immutableMultiUndirected struct {
+	multiUndirected
+}
+
+// Adds new edges to the graph.
+func (g *immutableMultiUndirected) addEdges(edges ...Edge) {
+	for _, edge := range edges {
+		g.addEdge(NewEdge(edge.Both()))
+	}
+}
+
+type mutableMultiUndirected struct {
+	multiUndirectedMut
+}
+
+// Adds edges to the graph. Each provided edge is added as a new edge, even if an
+// identical edge is already present.
+func (g *mutableMultiUndirected) AddEdges(edges ...Edge) {
+	if len(edges) == 0 {
+		return
+	}
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	g.addEdges(edges...)
+}
+
+// Adds new edges to the graph.
+func (g *mutableMultiUndirected) addEdges(edges ...Edge) {
+	for _, edge := range edges {
+		g.addEdge(NewEdge(edge.Both()))
+	}
+}
+
+// Removes edges from the graph. For each provided edge, one edge connecting the
+// same vertices is removed. This does NOT remove vertex members of the removed edges.
+func (g *mutableMultiUndirected) RemoveEdges(edges ...Edge) {
+	if len(edges) == 0 {
+		return
+	}
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	for _, edge := range edges {
+		g.removeEdge(edge, anyEdge)
+	}
+}
+
+/* Weighted edges */
+
+type immutableWeightedMultiUndirected struct {
+	multiUndirected
+}
+
+// Indicates whether or not any edge connecting the vertices of the given edge
+// has the same weight.
+func (g *immutableWeightedMultiUndirected) HasWeightedEdge(edge WeightedEdge) bool {
+	return g.hasMatching(edge, sameWeight(edge.Weight()))
+}
+
+// Adds new edges to the graph.
+func (g *immutableWeightedMultiUndirected) addEdges(edges ...WeightedEdge) {
+	for _, edge := range edges {
+		u, v := edge.Both()
+		g.addEdge(NewWeightedEdge(u, v, edge.Weight()))
+	}
+}
+
+type weightedMultiUndirected struct {
+	multiUndirectedMut
+}
+
+// Indicates whether or not any edge connecting the vertices of the given edge
+// has the same weight.
+func (g *weightedMultiUndirected) HasWeightedEdge(edge WeightedEdge) bool {
+	return g.hasMatching(edge, sameWeight(edge.Weight()))
+}
+
+// Adds edges to the graph. Each provided edge is added as a new edge, even if an
+// identical edge is already present.
+func (g *weightedMultiUndirected) AddEdges(edges ...WeightedEdge) {
+	if len(edges) == 0 {
+		return
+	}
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	g.addEdges(edges...)
+}
+
+// Adds new edges to the graph.
+func (g *weightedMultiUndirected) addEdges(edges ...WeightedEdge) {
+	for _, edge := range edges {
+		u, v := edge.Both()
+		g.addEdge(NewWeightedEdge(u, v, edge.Weight()))
+	}
+}
+
+// Removes edges from the graph. For each provided edge, one edge connecting the
+// same vertices with the same weight is removed. This does NOT remove vertex
+// members of the removed edges.
+func (g *weightedMultiUndirected) RemoveEdges(edges ...WeightedEdge) {
+	if len(edges) == 0 {
+		return
+	}
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	for _, edge := range edges {
+		g.removeEdge(edge, sameWeight(edge.Weight()))
+	}
+}
+
+/* Labeled edges */
+
+type immutableLabeledMultiUndirected struct {
+	multiUndirected
+}
+
+// Indicates whether or not any edge connecting the vertices of the given edge
+// has the same label.
+func (g *immutableLabeledMultiUndirected) HasLabeledEdge(edge LabeledEdge) bool {
+	return g.hasMatching(edge, sameLabel(edge.Label()))
+}
+
+// Adds new edges to the graph.
+func (g *immutableLabeledMultiUndirected) addEdges(edges ...LabeledEdge) {
+	for _, edge := range edges {
+		u, v := edge.Both()
+		g.addEdge(NewLabeledEdge(u, v, edge.Label()))
+	}
+}
+
+type labeledMultiUndirected struct {
+	multiUndirectedMut
+}
+
+// Indicates whether or not any edge connecting the vertices of the given edge
+// has the same label.
+func (g *labeledMultiUndirected) HasLabeledEdge(edge LabeledEdge) bool {
+	return g.hasMatching(edge, sameLabel(edge.Label()))
+}
+
+// Adds edges to the graph. Each provided edge is added as a new edge, even if an
+// identical edge is already present.
+func (g *labeledMultiUndirected) AddEdges(edges ...LabeledEdge) {
+	if len(edges) == 0 {
+		return
+	}
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	g.addEdges(edges...)
+}
+
+// Adds new edges to the graph.
+func (g *labeledMultiUndirected) addEdges(edges ...LabeledEdge) {
+	for _, edge := range edges {
+		u, v := edge.Both()
+		g.addEdge(NewLabeledEdge(u, v, edge.Label()))
+	}
+}
+
+// Removes edges from the graph. For each provided edge, one edge connecting the
+// same vertices with the same label is removed. This does NOT remove vertex
+// members of the removed edges.
+func (g *labeledMultiUndirected) RemoveEdges(edges ...LabeledEdge) {
+	if len(edges) == 0 {
+		return
+	}
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	for _, edge := range edges {
+		g.removeEdge(edge, sameLabel(edge.Label()))
+	}
+}
+
+/* Data edges */
+
+type immutableDataMultiUndirected struct {
+	multiUndirected
+}
+
+// Indicates whether or not any edge connecting the vertices of the given edge
+// carries equal data.
+func (g *immutableDataMultiUndirected) HasDataEdge(edge DataEdge) bool {
+	return g.hasMatching(edge, sameData(edge.Data()))
+}
+
+// Adds new edges to the graph.
+func (g *immutableDataMultiUndirected) addEdges(edges ...DataEdge) {
+	for _, edge := range edges {
+		u, v := edge.Both()
+		g.addEdge(NewDataEdge(u, v, edge.Data()))
+	}
+}
+
+type dataMultiUndirected struct {
+	multiUndirectedMut
+}
+
+// Indicates whether or not any edge connecting the vertices of the given edge
+// carries equal data.
+func (g *dataMultiUndirected) HasDataEdge(edge DataEdge) bool {
+	return g.hasMatching(edge, sameData(edge.Data()))
+}
+
+// Adds edges to the graph. Each provided edge is added as a new edge, even if an
+// identical edge is already present.
+func (g *dataMultiUndirected) AddEdges(edges ...DataEdge) {
+	if len(edges) == 0 {
+		return
+	}
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	g.addEdges(edges...)
+}
+
+// Adds new edges to the graph.
+func (g *dataMultiUndirected) addEdges(edges ...DataEdge) {
+	for _, edge := range edges {
+		u, v := edge.Both()
+		g.addEdge(NewDataEdge(u, v, edge.Data()))
+	}
+}
+
+// Removes edges from the graph. For each provided edge, one edge connecting the
+// same vertices with equal data is removed. This does NOT remove vertex members
+// of the removed edges.
+func (g *dataMultiUndirected) RemoveEdges(edges ...DataEdge) {
+	if len(edges) == 0 {
+		return
+	}
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	for _, edge := range edges {
+		g.removeEdge(edge, sameData(edge.Data()))
+	}
+}
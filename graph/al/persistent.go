@@ -0,0 +1,895 @@
+package al
+
+import (
+	. "github.com/sdboyer/gogl"
+)
+
+/*
+Persistent graphs are never modified in place. Each of their mutators returns a new
+version of the graph, leaving the one it was called on exactly as it was; a
+sequence of mutations thus produces a history of versions, any of which can be
+kept and read from indefinitely - for undo, say, or to compare states.
+
+The adjacency lists behind persistent graphs are hamts, persistent hash tries:
+an outer hamt mapping each vertex to an inner hamt of its adjacent vertices. A
+mutation copies only the trie nodes along the paths to the entries it changes,
+so each version costs O(log V) additional memory per changed edge, and shares
+everything else with its predecessor. Because no version ever changes, none of
+them need locking.
+
+Directed persistent graphs index their arcs by target as well as by source, which
+makes indegree checks and in-arc enumeration as cheap as their outbound
+counterparts, and makes transposition a constant-time operation.
+*/
+
+// Builds the edges and arcs of a persistent graph from their endpoints and the
+// property held between them: nothing, a weight, a label or arbitrary data.
+type edgeKind struct {
+	edge func(u, v Vertex, p interface{}) Edge
+	arc  func(u, v Vertex, p interface{}) Arc
+}
+
+var (
+	basicKind = &edgeKind{
+		edge: func(u, v Vertex, _ interface{}) Edge { return NewEdge(u, v) },
+		arc:  func(u, v Vertex, _ interface{}) Arc { return NewArc(u, v) },
+	}
+	weightedKind = &edgeKind{
+		edge: func(u, v Vertex, p interface{}) Edge { return NewWeightedEdge(u, v, p.(float64)) },
+		arc:  func(u, v Vertex, p interface{}) Arc { return NewWeightedArc(u, v, p.(float64)) },
+	}
+	labeledKind = &edgeKind{
+		edge: func(u, v Vertex, p interface{}) Edge { return NewLabeledEdge(u, v, p.(string)) },
+		arc:  func(u, v Vertex, p interface{}) Arc { return NewLabeledArc(u, v, p.(string)) },
+	}
+	dataKind = &edgeKind{
+		edge: func(u, v Vertex, p interface{}) Edge { return NewDataEdge(u, v, p) },
+		arc:  func(u, v Vertex, p interface{}) Arc { return NewDataArc(u, v, p) },
+	}
+)
+
+// Contains the vertex set and outbound adjacency shared by all persistent graphs.
+// The list maps each vertex to a hamt of its adjacent vertices, each holding the
+// property of the edge to it.
+type basePersistent struct {
+	list hamt
+	size int
+	kind *edgeKind
+}
+
+// Returns the hamt of vertices adjacent to the provided vertex.
+func (g *basePersistent) adjacent(vertex Vertex) hamt {
+	adj, _ := g.list.get(vertex)
+	m, _ := adj.(hamt)
+	return m
+}
+
+// Returns the property held on the edge from u to v, and whether there is such an edge.
+func (g *basePersistent) property(u, v Vertex) (interface{}, bool) {
+	return g.adjacent(u).get(v)
+}
+
+// Indicates whether or not the given vertex is present in the graph.
+func (g *basePersistent) hasVertex(vertex Vertex) (exists bool) {
+	_, exists = g.list.get(vertex)
+	return
+}
+
+// Indicates whether or not the given vertex is present in the graph.
+func (g *basePersistent) HasVertex(vertex Vertex) bool {
+	return g.hasVertex(vertex)
+}
+
+// Traverses the graph's vertices in random order, passing each vertex to the
+// provided closure.
+func (g *basePersistent) Vertices(f VertexStep) {
+	g.list.each(func(v Vertex, _ interface{}) bool {
+		return f(v)
+	})
+}
+
+// Returns the order (number of vertices) in the graph.
+func (g *basePersistent) Order() int {
+	return g.list.len()
+}
+
+// Returns the size (number of edges) in the graph.
+func (g *basePersistent) Size() int {
+	return g.size
+}
+
+// Indicates whether the edge from u to v is present and holds the provided property.
+func (g *basePersistent) holds(u, v Vertex, p interface{}) bool {
+	q, exists := g.property(u, v)
+	return exists && q == p
+}
+
+// The core of an undirected persistent graph. Each edge is held at both of its ends.
+//
+// The unexported mutators change the receiver in place. They are used only on fresh
+// copies of a version, or while a new graph is being populated.
+type basePersistentUndirected struct {
+	basePersistent
+}
+
+// Adds the provided vertices to the graph. If a provided vertex is
+// already present in the graph, it is a no-op (for that vertex only).
+func (g *basePersistentUndirected) ensureVertex(vertices ...Vertex) {
+	for _, vertex := range vertices {
+		if !g.hasVertex(vertex) {
+			g.list = g.list.set(vertex, hamt{})
+		}
+	}
+}
+
+// Removes a vertex, along with all its incident edges.
+func (g *basePersistentUndirected) removeVertex(vertex Vertex) {
+	if !g.hasVertex(vertex) {
+		return
+	}
+
+	g.adjacent(vertex).each(func(adjacent Vertex, _ interface{}) (terminate bool) {
+		if adjacent != vertex {
+			g.list = g.list.set(adjacent, g.adjacent(adjacent).delete(vertex))
+		}
+		g.size--
+		return
+	})
+	g.list = g.list.delete(vertex)
+}
+
+// Adds an edge between u and v holding the provided property, unless the two are
+// already connected.
+func (g *basePersistentUndirected) link(u, v Vertex, p interface{}) {
+	g.ensureVertex(u, v)
+	if _, exists := g.property(u, v); exists {
+		return
+	}
+
+	g.list = g.list.set(u, g.adjacent(u).set(v, p))
+	g.list = g.list.set(v, g.adjacent(v).set(u, p))
+	g.size++
+}
+
+// Removes the edge between u and v, if present.
+func (g *basePersistentUndirected) unlink(u, v Vertex) {
+	if _, exists := g.property(u, v); !exists {
+		return
+	}
+
+	g.list = g.list.set(u, g.adjacent(u).delete(v))
+	g.list = g.list.set(v, g.adjacent(v).delete(u))
+	g.size--
+}
+
+// Returns the degree of the provided vertex. If the vertex is not present in the
+// graph, the second return value will be false.
+func (g *basePersistentUndirected) DegreeOf(vertex Vertex) (degree int, exists bool) {
+	if exists = g.hasVertex(vertex); exists {
+		degree = g.adjacent(vertex).len()
+	}
+	return
+}
+
+// Traverses the set of edges in the graph, passing each edge to the
+// provided closure.
+func (g *basePersistentUndirected) Edges(f EdgeStep) {
+	// Each edge is visited from both ends; only pass it on the first visit.
+	visited := make(map[Vertex]struct{}, g.Order())
+	g.list.each(func(source Vertex, adj interface{}) bool {
+		terminate := adj.(hamt).each(func(target Vertex, p interface{}) bool {
+			if _, seen := visited[target]; seen {
+				return false
+			}
+			return f(g.kind.edge(source, target, p))
+		})
+		visited[source] = keyExists
+		return terminate
+	})
+}
+
+// Enumerates the set of all edges incident to the provided vertex.
+func (g *basePersistentUndirected) IncidentTo(v Vertex, f EdgeStep) {
+	g.adjacent(v).each(func(adjacent Vertex, p interface{}) bool {
+		return f(g.kind.edge(v, adjacent, p))
+	})
+}
+
+// Enumerates the vertices adjacent to the provided vertex.
+func (g *basePersistentUndirected) AdjacentTo(vertex Vertex, f VertexStep) {
+	g.adjacent(vertex).each(func(adjacent Vertex, _ interface{}) bool {
+		return f(adjacent)
+	})
+}
+
+// Indicates whether or not the given edge is present in the graph. It matches
+// based solely on the presence of an edge, disregarding any weight, label or data.
+func (g *basePersistentUndirected) HasEdge(edge Edge) bool {
+	_, exists := g.property(edge.Both())
+	return exists
+}
+
+// Returns the density of the graph. Density is the ratio of edge count to the
+// number of edges there would be in complete graph (maximum edge count).
+func (g *basePersistentUndirected) Density() float64 {
+	order := g.Order()
+	return 2 * float64(g.Size()) / float64(order*(order-1))
+}
+
+// The core of a directed persistent graph. Arcs are held in the list by their
+// source, and again in a second list, by their target.
+type basePersistentDirected struct {
+	basePersistent
+	in hamt
+}
+
+// Returns the hamt of vertices with arcs to the provided vertex.
+func (g *basePersistentDirected) predecessors(vertex Vertex) hamt {
+	adj, _ := g.in.get(vertex)
+	m, _ := adj.(hamt)
+	return m
+}
+
+// Adds the provided vertices to the graph. If a provided vertex is
+// already present in the graph, it is a no-op (for that vertex only).
+func (g *basePersistentDirected) ensureVertex(vertices ...Vertex) {
+	for _, vertex := range vertices {
+		if !g.hasVertex(vertex) {
+			g.list = g.list.set(vertex, hamt{})
+			g.in = g.in.set(vertex, hamt{})
+		}
+	}
+}
+
+// Removes a vertex, along with all its incident arcs.
+func (g *basePersistentDirected) removeVertex(vertex Vertex) {
+	if !g.hasVertex(vertex) {
+		return
+	}
+
+	g.adjacent(vertex).each(func(target Vertex, _ interface{}) (terminate bool) {
+		g.in = g.in.set(target, g.predecessors(target).delete(vertex))
+		g.size--
+		return
+	})
+	g.predecessors(vertex).each(func(source Vertex, _ interface{}) (terminate bool) {
+		// A loop was already counted among the out-arcs.
+		if source != vertex {
+			g.list = g.list.set(source, g.adjacent(source).delete(vertex))
+			g.size--
+		}
+		return
+	})
+
+	g.list = g.list.delete(vertex)
+	g.in = g.in.delete(vertex)
+}
+
+// Adds an arc from u to v holding the provided property, unless there already is one.
+func (g *basePersistentDirected) link(u, v Vertex, p interface{}) {
+	g.ensureVertex(u, v)
+	if _, exists := g.property(u, v); exists {
+		return
+	}
+
+	g.list = g.list.set(u, g.adjacent(u).set(v, p))
+	g.in = g.in.set(v, g.predecessors(v).set(u, p))
+	g.size++
+}
+
+// Removes the arc from u to v, if present.
+func (g *basePersistentDirected) unlink(u, v Vertex) {
+	if _, exists := g.property(u, v); !exists {
+		return
+	}
+
+	g.list = g.list.set(u, g.adjacent(u).delete(v))
+	g.in = g.in.set(v, g.predecessors(v).delete(u))
+	g.size--
+}
+
+// Returns the core of the transposed graph, which simply swaps the two lists.
+func (g *basePersistentDirected) transpose() basePersistentDirected {
+	g2 := *g
+	g2.list, g2.in = g.in, g.list
+	return g2
+}
+
+// Returns the outdegree of the provided vertex. If the vertex is not present in the
+// graph, the second return value will be false.
+func (g *basePersistentDirected) OutDegreeOf(vertex Vertex) (degree int, exists bool) {
+	if exists = g.hasVertex(vertex); exists {
+		degree = g.adjacent(vertex).len()
+	}
+	return
+}
+
+// Returns the indegree of the provided vertex. If the vertex is not present in the
+// graph, the second return value will be false.
+func (g *basePersistentDirected) InDegreeOf(vertex Vertex) (degree int, exists bool) {
+	if exists = g.hasVertex(vertex); exists {
+		degree = g.predecessors(vertex).len()
+	}
+	return
+}
+
+// Returns the degree of the given vertex, counting both in and out-edges.
+func (g *basePersistentDirected) DegreeOf(vertex Vertex) (degree int, exists bool) {
+	if exists = g.hasVertex(vertex); exists {
+		degree = g.adjacent(vertex).len() + g.predecessors(vertex).len()
+	}
+	return
+}
+
+// Traverses the set of edges in the graph, passing each edge to the
+// provided closure.
+func (g *basePersistentDirected) Edges(f EdgeStep) {
+	g.list.each(func(source Vertex, adj interface{}) bool {
+		return adj.(hamt).each(func(target Vertex, p interface{}) bool {
+			return f(g.kind.edge(source, target, p))
+		})
+	})
+}
+
+// Traverses the set of arcs in the graph, passing each arc to the
+// provided closure.
+func (g *basePersistentDirected) Arcs(f ArcStep) {
+	g.list.each(func(source Vertex, adj interface{}) bool {
+		return adj.(hamt).each(func(target Vertex, p interface{}) bool {
+			return f(g.kind.arc(source, target, p))
+		})
+	})
+}
+
+// Enumerates the set of all edges incident to the provided vertex.
+func (g *basePersistentDirected) IncidentTo(v Vertex, f EdgeStep) {
+	var terminate bool
+	interloper := func(a Arc) bool {
+		terminate = terminate || f(a)
+		return terminate
+	}
+
+	g.ArcsFrom(v, interloper)
+	g.ArcsTo(v, interloper)
+}
+
+// Enumerates the vertices adjacent to the provided vertex.
+func (g *basePersistentDirected) AdjacentTo(vertex Vertex, f VertexStep) {
+	g.IncidentTo(vertex, func(e Edge) bool {
+		u, v := e.Both()
+		if u == vertex {
+			return f(v)
+		}
+		return f(u)
+	})
+}
+
+// Enumerates the set of out-edges for the provided vertex.
+func (g *basePersistentDirected) ArcsFrom(v Vertex, f ArcStep) {
+	g.adjacent(v).each(func(target Vertex, p interface{}) bool {
+		return f(g.kind.arc(v, target, p))
+	})
+}
+
+// Enumerates the set of in-edges for the provided vertex.
+func (g *basePersistentDirected) ArcsTo(v Vertex, f ArcStep) {
+	g.predecessors(v).each(func(source Vertex, p interface{}) bool {
+		return f(g.kind.arc(source, v, p))
+	})
+}
+
+// Enumerates the vertices to which the provided vertex has an out-arc.
+func (g *basePersistentDirected) SuccessorsOf(v Vertex, f VertexStep) {
+	g.adjacent(v).each(func(target Vertex, _ interface{}) bool {
+		return f(target)
+	})
+}
+
+// Enumerates the vertices from which the provided vertex has an in-arc.
+func (g *basePersistentDirected) PredecessorsOf(v Vertex, f VertexStep) {
+	g.predecessors(v).each(func(source Vertex, _ interface{}) bool {
+		return f(source)
+	})
+}
+
+// Indicates whether or not the given edge is present in the graph, in either
+// direction. It matches based solely on the presence of an edge, disregarding any
+// weight, label or data.
+func (g *basePersistentDirected) HasEdge(edge Edge) bool {
+	u, v := edge.Both()
+	_, exists := g.property(u, v)
+	if !exists {
+		_, exists = g.property(v, u)
+	}
+	return exists
+}
+
+// Indicates whether or not the given arc is present in the graph.
+func (g *basePersistentDirected) HasArc(arc Arc) bool {
+	_, exists := g.property(arc.Both())
+	return exists
+}
+
+// Returns the density of the graph. Density is the ratio of edge count to the
+// number of edges there would be in complete graph (maximum edge count).
+func (g *basePersistentDirected) Density() float64 {
+	order := g.Order()
+	return float64(g.Size()) / float64(order*(order-1))
+}
+
+/* Basic edges */
+
+type persistentUndirected struct {
+	basePersistentUndirected
+}
+
+// Returns a version of the graph in which the provided vertices are present.
+func (g *persistentUndirected) EnsureVertex(vertices ...Vertex) Graph {
+	g2 := *g
+	g2.ensureVertex(vertices...)
+	return &g2
+}
+
+// Returns a version of the graph without the provided vertices, or any edges
+// incident to them.
+func (g *persistentUndirected) RemoveVertex(vertices ...Vertex) Graph {
+	g2 := *g
+	for _, vertex := range vertices {
+		g2.removeVertex(vertex)
+	}
+	return &g2
+}
+
+// Returns a version of the graph with the provided edges added.
+func (g *persistentUndirected) AddEdges(edges ...Edge) Graph {
+	g2 := *g
+	g2.addEdges(edges...)
+	return &g2
+}
+
+// Adds edges to the graph in place.
+func (g *persistentUndirected) addEdges(edges ...Edge) {
+	for _, edge := range edges {
+		u, v := edge.Both()
+		g.link(u, v, nil)
+	}
+}
+
+// Returns a version of the graph with the provided edges removed. This does NOT
+// remove vertex members of the removed edges.
+func (g *persistentUndirected) RemoveEdges(edges ...Edge) Graph {
+	g2 := *g
+	for _, edge := range edges {
+		g2.unlink(edge.Both())
+	}
+	return &g2
+}
+
+type persistentDirected struct {
+	basePersistentDirected
+}
+
+// Returns a version of the graph in which the provided vertices are present.
+func (g *persistentDirected) EnsureVertex(vertices ...Vertex) Graph {
+	g2 := *g
+	g2.ensureVertex(vertices...)
+	return &g2
+}
+
+// Returns a version of the graph without the provided vertices, or any arcs
+// incident to them.
+func (g *persistentDirected) RemoveVertex(vertices ...Vertex) Graph {
+	g2 := *g
+	for _, vertex := range vertices {
+		g2.removeVertex(vertex)
+	}
+	return &g2
+}
+
+// Returns a version of the graph with the provided arcs added.
+func (g *persistentDirected) AddArcs(arcs ...Arc) Graph {
+	g2 := *g
+	g2.addArcs(arcs...)
+	return &g2
+}
+
+// Adds arcs to the graph in place.
+func (g *persistentDirected) addArcs(arcs ...Arc) {
+	for _, arc := range arcs {
+		g.link(arc.Source(), arc.Target(), nil)
+	}
+}
+
+// Returns a version of the graph with the provided arcs removed. This does NOT
+// remove vertex members of the removed arcs.
+func (g *persistentDirected) RemoveArcs(arcs ...Arc) Graph {
+	g2 := *g
+	for _, arc := range arcs {
+		g2.unlink(arc.Both())
+	}
+	return &g2
+}
+
+// Returns a graph with the same vertex and edge set, but with the
+// directionality of all its edges reversed.
+//
+// The transpose shares all of its structure with the original, and is
+// produced in constant time.
+func (g *persistentDirected) Transpose() Digraph {
+	return &persistentDirected{g.transpose()}
+}
+
+/* Weighted edges */
+
+type persistentWeightedUndirected struct {
+	basePersistentUndirected
+}
+
+// Indicates whether or not the given weighted edge is present in the graph.
+// It will only match if the provided WeightedEdge has the same weight as
+// the edge contained in the graph.
+func (g *persistentWeightedUndirected) HasWeightedEdge(edge WeightedEdge) bool {
+	u, v := edge.Both()
+	return g.holds(u, v, edge.Weight())
+}
+
+// Returns a version of the graph in which the provided vertices are present.
+func (g *persistentWeightedUndirected) EnsureVertex(vertices ...Vertex) Graph {
+	g2 := *g
+	g2.ensureVertex(vertices...)
+	return &g2
+}
+
+// Returns a version of the graph without the provided vertices, or any edges
+// incident to them.
+func (g *persistentWeightedUndirected) RemoveVertex(vertices ...Vertex) Graph {
+	g2 := *g
+	for _, vertex := range vertices {
+		g2.removeVertex(vertex)
+	}
+	return &g2
+}
+
+// Returns a version of the graph with the provided edges added.
+func (g *persistentWeightedUndirected) AddEdges(edges ...WeightedEdge) Graph {
+	g2 := *g
+	g2.addEdges(edges...)
+	return &g2
+}
+
+// Adds edges to the graph in place.
+func (g *persistentWeightedUndirected) addEdges(edges ...WeightedEdge) {
+	for _, edge := range edges {
+		u, v := edge.Both()
+		g.link(u, v, edge.Weight())
+	}
+}
+
+// Returns a version of the graph with the provided edges removed, regardless of
+// their weight. This does NOT remove vertex members of the removed edges.
+func (g *persistentWeightedUndirected) RemoveEdges(edges ...WeightedEdge) Graph {
+	g2 := *g
+	for _, edge := range edges {
+		g2.unlink(edge.Both())
+	}
+	return &g2
+}
+
+type persistentWeightedDirected struct {
+	basePersistentDirected
+}
+
+// Indicates whether or not the given weighted edge is present in the graph,
+// in either direction. It will only match if the provided WeightedEdge has the
+// same weight as the edge contained in the graph.
+func (g *persistentWeightedDirected) HasWeightedEdge(edge WeightedEdge) bool {
+	u, v := edge.Both()
+	return g.holds(u, v, edge.Weight()) || g.holds(v, u, edge.Weight())
+}
+
+// Indicates whether or not the given weighted arc is present in the graph.
+// It will only match if the provided WeightedArc has the same weight as
+// the arc contained in the graph.
+func (g *persistentWeightedDirected) HasWeightedArc(arc WeightedArc) bool {
+	return g.holds(arc.Source(), arc.Target(), arc.Weight())
+}
+
+// Returns a version of the graph in which the provided vertices are present.
+func (g *persistentWeightedDirected) EnsureVertex(vertices ...Vertex) Graph {
+	g2 := *g
+	g2.ensureVertex(vertices...)
+	return &g2
+}
+
+// Returns a version of the graph without the provided vertices, or any arcs
+// incident to them.
+func (g *persistentWeightedDirected) RemoveVertex(vertices ...Vertex) Graph {
+	g2 := *g
+	for _, vertex := range vertices {
+		g2.removeVertex(vertex)
+	}
+	return &g2
+}
+
+// Returns a version of the graph with the provided arcs added.
+func (g *persistentWeightedDirected) AddArcs(arcs ...WeightedArc) Graph {
+	g2 := *g
+	g2.addArcs(arcs...)
+	return &g2
+}
+
+// Adds arcs to the graph in place.
+func (g *persistentWeightedDirected) addArcs(arcs ...WeightedArc) {
+	for _, arc := range arcs {
+		g.link(arc.Source(), arc.Target(), arc.Weight())
+	}
+}
+
+// Returns a version of the graph with the provided arcs removed, regardless of
+// their weight. This does NOT remove vertex members of the removed arcs.
+func (g *persistentWeightedDirected) RemoveArcs(arcs ...WeightedArc) Graph {
+	g2 := *g
+	for _, arc := range arcs {
+		g2.unlink(arc.Both())
+	}
+	return &g2
+}
+
+// Returns a graph with the same vertex and edge set, but with the
+// directionality of all its edges reversed.
+//
+// The transpose shares all of its structure with the original, and is
+// produced in constant time.
+func (g *persistentWeightedDirected) Transpose() Digraph {
+	return &persistentWeightedDirected{g.transpose()}
+}
+
+/* Labeled edges */
+
+type persistentLabeledUndirected struct {
+	basePersistentUndirected
+}
+
+// Indicates whether or not the given labeled edge is present in the graph.
+// It will only match if the provided LabeledEdge has the same label as
+// the edge contained in the graph.
+func (g *persistentLabeledUndirected) HasLabeledEdge(edge LabeledEdge) bool {
+	u, v := edge.Both()
+	return g.holds(u, v, edge.Label())
+}
+
+// Returns a version of the graph in which the provided vertices are present.
+func (g *persistentLabeledUndirected) EnsureVertex(vertices ...Vertex) Graph {
+	g2 := *g
+	g2.ensureVertex(vertices...)
+	return &g2
+}
+
+// Returns a version of the graph without the provided vertices, or any edges
+// incident to them.
+func (g *persistentLabeledUndirected) RemoveVertex(vertices ...Vertex) Graph {
+	g2 := *g
+	for _, vertex := range vertices {
+		g2.removeVertex(vertex)
+	}
+	return &g2
+}
+
+// Returns a version of the graph with the provided edges added.
+func (g *persistentLabeledUndirected) AddEdges(edges ...LabeledEdge) Graph {
+	g2 := *g
+	g2.addEdges(edges...)
+	return &g2
+}
+
+// Adds edges to the graph in place.
+func (g *persistentLabeledUndirected) addEdges(edges ...LabeledEdge) {
+	for _, edge := range edges {
+		u, v := edge.Both()
+		g.link(u, v, edge.Label())
+	}
+}
+
+// Returns a version of the graph with the provided edges removed, regardless of
+// their label. This does NOT remove vertex members of the removed edges.
+func (g *persistentLabeledUndirected) RemoveEdges(edges ...LabeledEdge) Graph {
+	g2 := *g
+	for _, edge := range edges {
+		g2.unlink(edge.Both())
+	}
+	return &g2
+}
+
+type persistentLabeledDirected struct {
+	basePersistentDirected
+}
+
+// Indicates whether or not the given labeled edge is present in the graph,
+// in either direction. It will only match if the provided LabeledEdge has the
+// same label as the edge contained in the graph.
+func (g *persistentLabeledDirected) HasLabeledEdge(edge LabeledEdge) bool {
+	u, v := edge.Both()
+	return g.holds(u, v, edge.Label()) || g.holds(v, u, edge.Label())
+}
+
+// Indicates whether or not the given labeled arc is present in the graph.
+// It will only match if the provided LabeledArc has the same label as
+// the arc contained in the graph.
+func (g *persistentLabeledDirected) HasLabeledArc(arc LabeledArc) bool {
+	return g.holds(arc.Source(), arc.Target(), arc.Label())
+}
+
+// Returns a version of the graph in which the provided vertices are present.
+func (g *persistentLabeledDirected) EnsureVertex(vertices ...Vertex) Graph {
+	g2 := *g
+	g2.ensureVertex(vertices...)
+	return &g2
+}
+
+// Returns a version of the graph without the provided vertices, or any arcs
+// incident to them.
+func (g *persistentLabeledDirected) RemoveVertex(vertices ...Vertex) Graph {
+	g2 := *g
+	for _, vertex := range vertices {
+		g2.removeVertex(vertex)
+	}
+	return &g2
+}
+
+// Returns a version of the graph with the provided arcs added.
+func (g *persistentLabeledDirected) AddArcs(arcs ...LabeledArc) Graph {
+	g2 := *g
+	g2.addArcs(arcs...)
+	return &g2
+}
+
+// Adds arcs to the graph in place.
+func (g *persistentLabeledDirected) addArcs(arcs ...LabeledArc) {
+	for _, arc := range arcs {
+		g.link(arc.Source(), arc.Target(), arc.Label())
+	}
+}
+
+// Returns a version of the graph with the provided arcs removed, regardless of
+// their label. This does NOT remove vertex members of the removed arcs.
+func (g *persistentLabeledDirected) RemoveArcs(arcs ...LabeledArc) Graph {
+	g2 := *g
+	for _, arc := range arcs {
+		g2.unlink(arc.Both())
+	}
+	return &g2
+}
+
+// Returns a graph with the same vertex and edge set, but with the
+// directionality of all its edges reversed.
+//
+// The transpose shares all of its structure with the original, and is
+// produced in constant time.
+func (g *persistentLabeledDirected) Transpose() Digraph {
+	return &persistentLabeledDirected{g.transpose()}
+}
+
+/* Data edges */
+
+type persistentDataUndirected struct {
+	basePersistentUndirected
+}
+
+// Indicates whether or not the given data edge is present in the graph.
+// It will only match if the provided DataEdge has the same data as
+// the edge contained in the graph.
+func (g *persistentDataUndirected) HasDataEdge(edge DataEdge) bool {
+	u, v := edge.Both()
+	return g.holds(u, v, edge.Data())
+}
+
+// Returns a version of the graph in which the provided vertices are present.
+func (g *persistentDataUndirected) EnsureVertex(vertices ...Vertex) Graph {
+	g2 := *g
+	g2.ensureVertex(vertices...)
+	return &g2
+}
+
+// Returns a version of the graph without the provided vertices, or any edges
+// incident to them.
+func (g *persistentDataUndirected) RemoveVertex(vertices ...Vertex) Graph {
+	g2 := *g
+	for _, vertex := range vertices {
+		g2.removeVertex(vertex)
+	}
+	return &g2
+}
+
+// Returns a version of the graph with the provided edges added.
+func (g *persistentDataUndirected) AddEdges(edges ...DataEdge) Graph {
+	g2 := *g
+	g2.addEdges(edges...)
+	return &g2
+}
+
+// Adds edges to the graph in place.
+func (g *persistentDataUndirected) addEdges(edges ...DataEdge) {
+	for _, edge := range edges {
+		u, v := edge.Both()
+		g.link(u, v, edge.Data())
+	}
+}
+
+// Returns a version of the graph with the provided edges removed, regardless of
+// their data. This does NOT remove vertex members of the removed edges.
+func (g *persistentDataUndirected) RemoveEdges(edges ...DataEdge) Graph {
+	g2 := *g
+	for _, edge := range edges {
+		g2.unlink(edge.Both())
+	}
+	return &g2
+}
+
+type persistentDataDirected struct {
+	basePersistentDirected
+}
+
+// Indicates whether or not the given data edge is present in the graph,
+// in either direction. It will only match if the provided DataEdge has the
+// same data as the edge contained in the graph.
+func (g *persistentDataDirected) HasDataEdge(edge DataEdge) bool {
+	u, v := edge.Both()
+	return g.holds(u, v, edge.Data()) || g.holds(v, u, edge.Data())
+}
+
+// Indicates whether or not the given data arc is present in the graph.
+// It will only match if the provided DataArc has the same data as
+// the arc contained in the graph.
+func (g *persistentDataDirected) HasDataArc(arc DataArc) bool {
+	return g.holds(arc.Source(), arc.Target(), arc.Data())
+}
+
+// Returns a version of the graph in which the provided vertices are present.
+func (g *persistentDataDirected) EnsureVertex(vertices ...Vertex) Graph {
+	g2 := *g
+	g2.ensureVertex(vertices...)
+	return &g2
+}
+
+// Returns a version of the graph without the provided vertices, or any arcs
+// incident to them.
+func (g *persistentDataDirected) RemoveVertex(vertices ...Vertex) Graph {
+	g2 := *g
+	for _, vertex := range vertices {
+		g2.removeVertex(vertex)
+	}
+	return &g2
+}
+
+// Returns a version of the graph with the provided arcs added.
+func (g *persistentDataDirected) AddArcs(arcs ...DataArc) Graph {
+	g2 := *g
+	g2.addArcs(arcs...)
+	return &g2
+}
+
+// Adds arcs to the graph in place.
+func (g *persistentDataDirected) addArcs(arcs ...DataArc) {
+	for _, arc := range arcs {
+		g.link(arc.Source(), arc.Target(), arc.Data())
+	}
+}
+
+// Returns a version of the graph with the provided arcs removed, regardless of
+// their data. This does NOT remove vertex members of the removed arcs.
+func (g *persistentDataDirected) RemoveArcs(arcs ...DataArc) Graph {
+	g2 := *g
+	for _, arc := range arcs {
+		g2.unlink(arc.Both())
+	}
+	return &g2
+}
+
+// Returns a graph with the same vertex and edge set, but with the
+// directionality of all its edges reversed.
+//
+// The transpose shares all of its structure with the original, and is
+// produced in constant time.
+func (g *persistentDataDirected) Transpose() Digraph {
+	return &persistentDataDirected{g.transpose()}
+}
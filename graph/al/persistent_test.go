@@ -0,0 +1,184 @@
+package al
+
+import (
+	"sync"
+
+	. "github.com/sdboyer/gocheck"
+	. "github.com/sdboyer/gogl"
+)
+
+type PersistentSuite struct{}
+
+var _ = Suite(&PersistentSuite{})
+
+func (s *PersistentSuite) TestCreate(c *C) {
+	specs := map[GraphSpec]interface{}{
+		Spec().Persistent():                        &persistentUndirected{},
+		Spec().Persistent().Directed():             &persistentDirected{},
+		Spec().Persistent().Weighted():             &persistentWeightedUndirected{},
+		Spec().Persistent().Directed().Weighted():  &persistentWeightedDirected{},
+		Spec().Persistent().Labeled():              &persistentLabeledUndirected{},
+		Spec().Persistent().Directed().Labeled():   &persistentLabeledDirected{},
+		Spec().Persistent().DataEdges():            &persistentDataUndirected{},
+		Spec().Persistent().Directed().DataEdges(): &persistentDataDirected{},
+	}
+	for gs, expected := range specs {
+		c.Assert(gs.Create(G), FitsTypeOf, expected)
+	}
+
+	// the ordinary mutable implementations are still chosen without persistence
+	c.Assert(Spec().Persistent().Mutable().Create(G), FitsTypeOf, &mutableUndirected{})
+
+	g := Spec().Persistent().Create(G)
+	_, ok := g.(PersistentGraph)
+	c.Assert(ok, Equals, true)
+	_, ok = g.(VertexSetMutator)
+	c.Assert(ok, Equals, false)
+
+	_, ok = Spec().Persistent().Directed().Create(G).(PersistentDigraph)
+	c.Assert(ok, Equals, true)
+	_, ok = Spec().Persistent().Weighted().Create(G).(PersistentWeightedGraph)
+	c.Assert(ok, Equals, true)
+	_, ok = Spec().Persistent().Labeled().Create(G).(PersistentLabeledGraph)
+	c.Assert(ok, Equals, true)
+	_, ok = Spec().Persistent().DataEdges().Create(G).(PersistentDataGraph)
+	c.Assert(ok, Equals, true)
+}
+
+func (s *PersistentSuite) TestVersionsAreUnchanged(c *C) {
+	g0 := Spec().Persistent().Create(G).(PersistentGraph)
+	g1 := g0.AddEdges(NewEdge("foo", "bar"), NewEdge("bar", "baz")).(PersistentGraph)
+	g2 := g1.EnsureVertex("qux").(PersistentGraph)
+	g3 := g2.RemoveEdges(NewEdge("baz", "bar")).(PersistentGraph)
+	g4 := g3.RemoveVertex("foo").(PersistentGraph)
+
+	c.Assert(Order(g0), Equals, 0)
+	c.Assert(Size(g0), Equals, 0)
+
+	c.Assert(Order(g1), Equals, 3)
+	c.Assert(Size(g1), Equals, 2)
+	c.Assert(g1.HasVertex("qux"), Equals, false)
+
+	c.Assert(Order(g2), Equals, 4)
+	c.Assert(Size(g2), Equals, 2)
+	c.Assert(g2.HasEdge(NewEdge("bar", "baz")), Equals, true)
+
+	c.Assert(Size(g3), Equals, 1)
+	c.Assert(g3.HasEdge(NewEdge("bar", "baz")), Equals, false)
+	degree, _ := g3.DegreeOf("baz")
+	c.Assert(degree, Equals, 0)
+
+	c.Assert(Order(g4), Equals, 3)
+	c.Assert(Size(g4), Equals, 0)
+	degree, _ = g4.DegreeOf("bar")
+	c.Assert(degree, Equals, 0)
+
+	// and the earlier versions still see their own edges
+	degree, _ = g2.DegreeOf("bar")
+	c.Assert(degree, Equals, 2)
+	c.Assert(g3.HasEdge(NewEdge("foo", "bar")), Equals, true)
+
+	// re-adding an edge already present changes nothing
+	c.Assert(Size(g1.AddEdges(NewEdge("bar", "foo"))), Equals, 2)
+}
+
+func (s *PersistentSuite) TestUndo(c *C) {
+	history := []PersistentDigraph{Spec().Persistent().Directed().Create(G).(PersistentDigraph)}
+	sizes := []int{0}
+	for i := 0; i < 50; i++ {
+		g := history[len(history)-1].AddArcs(NewArc(i, i+1)).(PersistentDigraph)
+		if i%5 == 4 {
+			g = g.RemoveVertex(i).(PersistentDigraph)
+		}
+		history = append(history, g)
+		sizes = append(sizes, Size(g))
+	}
+
+	// undo back to the start; each version is just as it was left
+	for i := len(history) - 1; i > 0; i-- {
+		g := history[i]
+		c.Assert(Size(g), Equals, sizes[i])
+		c.Assert(g.HasArc(NewArc(i-1, i)), Equals, i%5 != 0)
+		c.Assert(g.HasVertex(i+1), Equals, false)
+	}
+	c.Assert(Order(history[0]), Equals, 0)
+}
+
+func (s *PersistentSuite) TestDigraph(c *C) {
+	g := Spec().Persistent().Directed().Weighted().Using(WeightedArcList{
+		NewWeightedArc("foo", "bar", 1.5),
+		NewWeightedArc("bar", "baz", 2),
+		NewWeightedArc("baz", "baz", 3),
+	}).Create(G).(WeightedDigraph)
+	m := g.(PersistentWeightedArcSetMutator)
+
+	in, _ := g.InDegreeOf("baz")
+	out, _ := g.OutDegreeOf("baz")
+	c.Assert(in, Equals, 2)
+	c.Assert(out, Equals, 1)
+
+	tg := g.Transpose().(WeightedDigraph)
+	c.Assert(Size(tg), Equals, 3)
+	c.Assert(tg.HasWeightedArc(NewWeightedArc("bar", "foo", 1.5)), Equals, true)
+	c.Assert(tg.HasWeightedArc(NewWeightedArc("foo", "bar", 1.5)), Equals, false)
+	in, _ = tg.InDegreeOf("baz")
+	c.Assert(in, Equals, 1)
+
+	// removing a vertex takes its loop and both directions of arcs with it
+	g2 := g.(PersistentVertexSetMutator).RemoveVertex("baz").(WeightedDigraph)
+	c.Assert(Size(g2), Equals, 1)
+	out, _ = g2.OutDegreeOf("bar")
+	c.Assert(out, Equals, 0)
+	c.Assert(Size(g), Equals, 3)
+
+	// removal disregards weight
+	g2 = m.RemoveArcs(NewWeightedArc("foo", "bar", 0)).(WeightedDigraph)
+	c.Assert(g2.HasArc(NewArc("foo", "bar")), Equals, false)
+	c.Assert(g.HasWeightedEdge(NewWeightedEdge("bar", "foo", 1.5)), Equals, true)
+}
+
+func (s *PersistentSuite) TestTypedEdges(c *C) {
+	lg := Spec().Persistent().Labeled().Create(G).(PersistentLabeledGraph)
+	lg2 := lg.AddEdges(NewLabeledEdge(1, 2, "foo")).(PersistentLabeledGraph)
+	c.Assert(lg2.HasLabeledEdge(NewLabeledEdge(2, 1, "foo")), Equals, true)
+	c.Assert(lg2.HasLabeledEdge(NewLabeledEdge(2, 1, "bar")), Equals, false)
+	c.Assert(lg.HasEdge(NewEdge(1, 2)), Equals, false)
+
+	g := Spec().Persistent().Directed().DataEdges().Create(G)
+	dg := g.(PersistentDataArcSetMutator).AddArcs(NewDataArc(1, 2, 42)).(DataDigraph)
+	c.Assert(dg.HasDataArc(NewDataArc(1, 2, 42)), Equals, true)
+	c.Assert(dg.HasDataArc(NewDataArc(2, 1, 42)), Equals, false)
+	c.Assert(dg.HasDataEdge(NewDataEdge(2, 1, 42)), Equals, true)
+
+	dg.ArcsTo(2, func(a Arc) (terminate bool) {
+		c.Assert(a.(DataArc).Data(), Equals, 42)
+		return
+	})
+}
+
+// Run with -race to verify that versions can be read while newer ones are derived.
+func (s *PersistentSuite) TestConcurrentVersions(c *C) {
+	var el EdgeList
+	for i := 0; i < 100; i++ {
+		el = append(el, NewEdge(i, i+1))
+	}
+	g := Spec().Persistent().Using(el).Create(G).(PersistentGraph)
+
+	var wg sync.WaitGroup
+	sizes := make([]int, 8)
+	for w := range sizes {
+		wg.Add(1)
+		go func(w int) {
+			defer wg.Done()
+			g2 := g.RemoveVertex(w).(PersistentGraph).AddEdges(NewEdge(w, -w))
+			sizes[w] = Size(g2)
+		}(w)
+	}
+	wg.Wait()
+
+	c.Assert(Size(g), Equals, 100)
+	c.Assert(sizes[0], Equals, 100)
+	for _, n := range sizes[1:] {
+		c.Assert(n, Equals, 99)
+	}
+}
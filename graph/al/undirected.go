@@ -159,3 +159,82 @@ func (g *mutableUndirected) RemoveEdges(edges ...Edge) {
 		}
 	}
 }
+
+/* immutableUndirected implementation */
+
+type immutableUndirected struct {
+	al_basic_immut
+}
+
+// Returns the degree of the provided vertex. If the vertex is not present in the
+// graph, the second return value will be false.
+func (g *immutableUndirected) DegreeOf(vertex Vertex) (degree int, exists bool) {
+	if exists = g.hasVertex(vertex); exists {
+		degree = len(g.list[vertex])
+	}
+	return
+}
+
+// Traverses the set of edges in the graph, passing each edge to the
+// provided closure.
+func (g *immutableUndirected) Edges(f EdgeStep) {
+	visited := set.NewNonTS()
+
+	for source, adjacent := range g.list {
+		for target := range adjacent {
+			e := NewEdge(source, target)
+			if !visited.Has(NewEdge(target, source)) {
+				visited.Add(e)
+				if f(e) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// Enumerates the set of all edges incident to the provided vertex.
+func (g *immutableUndirected) IncidentTo(v Vertex, f EdgeStep) {
+	if !g.hasVertex(v) {
+		return
+	}
+
+	for adjacent := range g.list[v] {
+		if f(NewEdge(v, adjacent)) {
+			return
+		}
+	}
+}
+
+// Enumerates the vertices adjacent to the provided vertex.
+func (g *immutableUndirected) AdjacentTo(vertex Vertex, f VertexStep) {
+	eachVertexInAdjacencyList(g.list, vertex, f)
+}
+
+// Indicates whether or not the given edge is present in the graph.
+func (g *immutableUndirected) HasEdge(edge Edge) bool {
+	u, v := edge.Both()
+	_, exists := g.list[u][v]
+	return exists
+}
+
+// Returns the density of the graph. Density is the ratio of edge count to the
+// number of edges there would be in complete graph (maximum edge count).
+func (g *immutableUndirected) Density() float64 {
+	order := g.Order()
+	return 2 * float64(g.Size()) / float64(order*(order-1))
+}
+
+// Adds a new edge to the graph.
+func (g *immutableUndirected) addEdges(edges ...Edge) {
+	for _, edge := range edges {
+		u, v := edge.Both()
+		g.ensureVertex(u, v)
+
+		if _, exists := g.list[u][v]; !exists {
+			g.list[u][v] = keyExists
+			g.list[v][u] = keyExists
+			g.size++
+		}
+	}
+}
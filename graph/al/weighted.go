@@ -557,3 +557,333 @@ func (g *weightedUndirected) RemoveEdges(edges ...WeightedEdge) {
 		}
 	}
 }
+
+/* Immutable weighted implementations */
+
+// The base for immutable weighted graphs. An immutable graph is populated once, when
+// it is created, and never changes thereafter; it therefore needs no locking, and
+// can be read from any number of goroutines at once.
+type baseImmutableWeighted struct {
+	list map[Vertex]map[Vertex]float64
+	size int
+}
+
+// Traverses the graph's vertices in random order, passing each vertex to the
+// provided closure.
+func (g *baseImmutableWeighted) Vertices(f VertexStep) {
+	for v := range g.list {
+		if f(v) {
+			return
+		}
+	}
+}
+
+// Indicates whether or not the given vertex is present in the graph.
+func (g *baseImmutableWeighted) HasVertex(vertex Vertex) bool {
+	return g.hasVertex(vertex)
+}
+
+// Indicates whether or not the given vertex is present in the graph.
+func (g *baseImmutableWeighted) hasVertex(vertex Vertex) (exists bool) {
+	_, exists = g.list[vertex]
+	return
+}
+
+// Returns the order (number of vertices) in the graph.
+func (g *baseImmutableWeighted) Order() int {
+	return len(g.list)
+}
+
+// Returns the size (number of edges) in the graph.
+func (g *baseImmutableWeighted) Size() int {
+	return g.size
+}
+
+// Adds the provided vertices to the graph. If a provided vertex is
+// already present in the graph, it is a no-op (for that vertex only).
+func (g *baseImmutableWeighted) ensureVertex(vertices ...Vertex) {
+	for _, vertex := range vertices {
+		if !g.hasVertex(vertex) {
+			g.list[vertex] = make(map[Vertex]float64, 10)
+		}
+	}
+}
+
+type immutableWeightedDirected struct {
+	baseImmutableWeighted
+}
+
+// Returns the outdegree of the provided vertex. If the vertex is not present in the
+// graph, the second return value will be false.
+func (g *immutableWeightedDirected) OutDegreeOf(vertex Vertex) (degree int, exists bool) {
+	if exists = g.hasVertex(vertex); exists {
+		degree = len(g.list[vertex])
+	}
+	return
+}
+
+// Returns the indegree of the provided vertex. If the vertex is not present in the
+// graph, the second return value will be false.
+//
+// Note that getting indegree is inefficient for directed adjacency lists; it requires
+// a full scan of the graph's edge set.
+func (g *immutableWeightedDirected) InDegreeOf(vertex Vertex) (degree int, exists bool) {
+	return inDegreeOf(g, vertex)
+}
+
+// Returns the degree of the given vertex, counting both in and out-edges.
+func (g *immutableWeightedDirected) DegreeOf(vertex Vertex) (degree int, exists bool) {
+	indegree, exists := inDegreeOf(g, vertex)
+	outdegree, exists := g.OutDegreeOf(vertex)
+	return indegree + outdegree, exists
+}
+
+// Enumerates the set of all edges incident to the provided vertex.
+func (g *immutableWeightedDirected) IncidentTo(v Vertex, f EdgeStep) {
+	eachEdgeIncidentToDirected(g, v, f)
+}
+
+// Enumerates the vertices adjacent to the provided vertex.
+func (g *immutableWeightedDirected) AdjacentTo(start Vertex, f VertexStep) {
+	g.IncidentTo(start, func(e Edge) bool {
+		u, v := e.Both()
+		if u == start {
+			return f(v)
+		} else {
+			return f(u)
+		}
+	})
+}
+
+// Enumerates the set of out-edges for the provided vertex.
+func (g *immutableWeightedDirected) ArcsFrom(v Vertex, f ArcStep) {
+	if !g.hasVertex(v) {
+		return
+	}
+
+	for adjacent, weight := range g.list[v] {
+		if f(NewWeightedArc(v, adjacent, weight)) {
+			return
+		}
+	}
+}
+
+func (g *immutableWeightedDirected) SuccessorsOf(v Vertex, f VertexStep) {
+	eachVertexInAdjacencyList(g.list, v, f)
+}
+
+// Enumerates the set of in-edges for the provided vertex.
+func (g *immutableWeightedDirected) ArcsTo(v Vertex, f ArcStep) {
+	if !g.hasVertex(v) {
+		return
+	}
+
+	for candidate, adjacent := range g.list {
+		for target, weight := range adjacent {
+			if target == v {
+				if f(NewWeightedArc(candidate, target, weight)) {
+					return
+				}
+			}
+		}
+	}
+}
+
+func (g *immutableWeightedDirected) PredecessorsOf(v Vertex, f VertexStep) {
+	eachPredecessorOf(g.list, v, f)
+}
+
+// Traverses the set of edges in the graph, passing each edge to the
+// provided closure.
+func (g *immutableWeightedDirected) Edges(f EdgeStep) {
+	for source, adjacent := range g.list {
+		for target, weight := range adjacent {
+			if f(NewWeightedEdge(source, target, weight)) {
+				return
+			}
+		}
+	}
+}
+
+// Traverses the set of arcs in the graph, passing each arc to the
+// provided closure.
+func (g *immutableWeightedDirected) Arcs(f ArcStep) {
+	for source, adjacent := range g.list {
+		for target, weight := range adjacent {
+			if f(NewWeightedArc(source, target, weight)) {
+				return
+			}
+		}
+	}
+}
+
+// Indicates whether or not the given edge is present in the graph. It matches
+// based solely on the presence of an edge, disregarding edge weight.
+func (g *immutableWeightedDirected) HasEdge(edge Edge) bool {
+	u, v := edge.Both()
+	_, exists := g.list[u][v]
+	if !exists {
+		_, exists = g.list[v][u]
+	}
+	return exists
+}
+
+// Indicates whether or not the given arc is present in the graph.
+func (g *immutableWeightedDirected) HasArc(arc Arc) bool {
+	_, exists := g.list[arc.Source()][arc.Target()]
+	return exists
+}
+
+// Indicates whether or not the given weighted edge is present in the graph.
+// It will only match if the provided WeightedEdge has the same weight as
+// the edge contained in the graph.
+func (g *immutableWeightedDirected) HasWeightedEdge(edge WeightedEdge) bool {
+	u, v := edge.Both()
+	if weight, exists := g.list[u][v]; exists {
+		return weight == edge.Weight()
+	} else if weight, exists = g.list[v][u]; exists {
+		return weight == edge.Weight()
+	}
+	return false
+}
+
+// Indicates whether or not the given weighted arc is present in the graph.
+// It will only match if the provided WeightedArc has the same weight as
+// the arc contained in the graph.
+func (g *immutableWeightedDirected) HasWeightedArc(arc WeightedArc) bool {
+	if weight, exists := g.list[arc.Source()][arc.Target()]; exists {
+		return weight == arc.Weight()
+	}
+	return false
+}
+
+// Returns the density of the graph. Density is the ratio of edge count to the
+// number of edges there would be in complete graph (maximum edge count).
+func (g *immutableWeightedDirected) Density() float64 {
+	order := g.Order()
+	return float64(g.Size()) / float64(order*(order-1))
+}
+
+// Returns a graph with the same vertex and edge set, but with the
+// directionality of all its edges reversed.
+//
+// This implementation returns a new graph object (doubling memory use),
+// but not all implementations do so.
+func (g *immutableWeightedDirected) Transpose() Digraph {
+	g2 := &immutableWeightedDirected{}
+	g2.list = make(map[Vertex]map[Vertex]float64, len(g.list))
+	g2.size = g.size
+
+	for source, adjacent := range g.list {
+		g2.ensureVertex(source)
+		for target, weight := range adjacent {
+			g2.ensureVertex(target)
+			g2.list[target][source] = weight
+		}
+	}
+
+	return g2
+}
+
+// Adds a new arc to the graph.
+func (g *immutableWeightedDirected) addArcs(arcs ...WeightedArc) {
+	for _, arc := range arcs {
+		g.ensureVertex(arc.Source(), arc.Target())
+
+		if _, exists := g.list[arc.Source()][arc.Target()]; !exists {
+			g.list[arc.Source()][arc.Target()] = arc.Weight()
+			g.size++
+		}
+	}
+}
+
+type immutableWeightedUndirected struct {
+	baseImmutableWeighted
+}
+
+// Returns the degree of the provided vertex. If the vertex is not present in the
+// graph, the second return value will be false.
+func (g *immutableWeightedUndirected) DegreeOf(vertex Vertex) (degree int, exists bool) {
+	if exists = g.hasVertex(vertex); exists {
+		degree = len(g.list[vertex])
+	}
+	return
+}
+
+// Traverses the set of edges in the graph, passing each edge to the
+// provided closure.
+func (g *immutableWeightedUndirected) Edges(f EdgeStep) {
+	visited := set.NewNonTS()
+
+	var e WeightedEdge
+	for source, adjacent := range g.list {
+		for target, weight := range adjacent {
+			e = NewWeightedEdge(source, target, weight)
+			if !visited.Has(NewEdge(e.Both())) {
+				visited.Add(NewEdge(target, source))
+				if f(e) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// Enumerates the set of all edges incident to the provided vertex.
+func (g *immutableWeightedUndirected) IncidentTo(v Vertex, f EdgeStep) {
+	if !g.hasVertex(v) {
+		return
+	}
+
+	for adjacent, weight := range g.list[v] {
+		if f(NewWeightedEdge(v, adjacent, weight)) {
+			return
+		}
+	}
+}
+
+// Enumerates the vertices adjacent to the provided vertex.
+func (g *immutableWeightedUndirected) AdjacentTo(vertex Vertex, f VertexStep) {
+	eachVertexInAdjacencyList(g.list, vertex, f)
+}
+
+// Indicates whether or not the given edge is present in the graph. It matches
+// based solely on the presence of an edge, disregarding edge weight.
+func (g *immutableWeightedUndirected) HasEdge(edge Edge) bool {
+	u, v := edge.Both()
+	_, exists := g.list[u][v]
+	return exists
+}
+
+// Indicates whether or not the given weighted edge is present in the graph.
+// It will only match if the provided WeightedEdge has the same weight as
+// the edge contained in the graph.
+func (g *immutableWeightedUndirected) HasWeightedEdge(edge WeightedEdge) bool {
+	u, v := edge.Both()
+	if weight, exists := g.list[u][v]; exists {
+		return edge.Weight() == weight
+	}
+	return false
+}
+
+// Returns the density of the graph. Density is the ratio of edge count to the
+// number of edges there would be in complete graph (maximum edge count).
+func (g *immutableWeightedUndirected) Density() float64 {
+	order := g.Order()
+	return 2 * float64(g.Size()) / float64(order*(order-1))
+}
+
+// Adds a new edge to the graph.
+func (g *immutableWeightedUndirected) addEdges(edges ...WeightedEdge) {
+	for _, edge := range edges {
+		u, v := edge.Both()
+		g.ensureVertex(u, v)
+
+		if _, exists := g.list[u][v]; !exists {
+			w := edge.Weight()
+			g.list[u][v] = w
+			g.list[v][u] = w
+			g.size++
+		}
+	}
+}
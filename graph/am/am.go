@@ -0,0 +1,158 @@
+package am
+
+import (
+	. "github.com/sdboyer/gogl"
+)
+
+/*
+Adjacency matrices represent a graph as a square grid of cells, one row and one
+column for each vertex, where the cell at (u, v) is filled if there is an edge from
+u to v. This makes checking for an edge between two vertices a constant-time
+operation, and enumerating in-arcs as cheap as out-arcs; it makes enumerating a
+vertex's neighbors, however, proportional to the order of the graph rather than
+the degree of the vertex.
+
+gogl's adjacency matrices hold one bit per cell, so the memory cost for the entire
+graph G is proportional to V^2 bits; weighted matrices additionally hold a float64
+per cell. This is compact for dense graphs, but wasteful for sparse ones, for which
+adjacency lists are generally the better choice.
+
+Only simple graphs with basic or weighted edges are implemented.
+*/
+
+var amCreators = map[GraphProperties]func() Graph{
+	GraphProperties(G_IMMUTABLE | G_DIRECTED | G_BASIC | G_SIMPLE): func() Graph {
+		return &immutableDirected{}
+	},
+	GraphProperties(G_IMMUTABLE | G_UNDIRECTED | G_BASIC | G_SIMPLE): func() Graph {
+		return &immutableUndirected{}
+	},
+	GraphProperties(G_MUTABLE | G_DIRECTED | G_BASIC | G_SIMPLE): func() Graph {
+		return &mutableDirected{}
+	},
+	GraphProperties(G_MUTABLE | G_UNDIRECTED | G_BASIC | G_SIMPLE): func() Graph {
+		return &mutableUndirected{}
+	},
+	GraphProperties(G_IMMUTABLE | G_DIRECTED | G_WEIGHTED | G_SIMPLE): func() Graph {
+		return &immutableWeightedDirected{directed{matrix{weighted: true}}}
+	},
+	GraphProperties(G_MUTABLE | G_DIRECTED | G_WEIGHTED | G_SIMPLE): func() Graph {
+		return &weightedDirected{directedMut{directed{matrix{weighted: true}}}}
+	},
+	GraphProperties(G_IMMUTABLE | G_UNDIRECTED | G_WEIGHTED | G_SIMPLE): func() Graph {
+		return &immutableWeightedUndirected{undirected{matrix{weighted: true}}}
+	},
+	GraphProperties(G_MUTABLE | G_UNDIRECTED | G_WEIGHTED | G_SIMPLE): func() Graph {
+		return &weightedUndirected{undirectedMut{undirected{matrix{weighted: true}}}}
+	},
+}
+
+// Create a graph implementation in the adjacency matrix style from the provided GraphSpec.
+//
+// If the GraphSpec contains a GraphSource, it will be imported into the provided graph.
+// If the GraphSpec indicates a graph type that is not currently implemented, this function
+// will panic.
+func G(gs GraphSpec) Graph {
+	gf, exists := amCreators[gs.Props]
+	if !exists {
+		panic("No graph implementation found for spec")
+	}
+
+	if gs.Source == nil {
+		return gf()
+	}
+
+	if gs.Props&G_DIRECTED == G_DIRECTED {
+		if dgs, ok := gs.Source.(DigraphSource); ok {
+			return functorToDirectedMatrix(dgs, gf().(am_graph))
+		}
+		panic("Cannot create a digraph from a graph.")
+	}
+	return functorToMatrix(gs.Source, gf().(am_graph))
+}
+
+type am_graph interface {
+	Graph
+	ensureVertex(...Vertex)
+}
+
+type am_ea interface {
+	am_graph
+	addEdges(...Edge)
+}
+
+type am_wea interface {
+	am_graph
+	addEdges(...WeightedEdge)
+}
+
+type am_dea interface {
+	am_graph
+	addArcs(...Arc)
+}
+
+type am_dwea interface {
+	am_graph
+	addArcs(...WeightedArc)
+}
+
+// Copies an incoming graph into any of the implemented undirected adjacency matrix
+// types. Weights are kept if the target is weighted; edges without a weight get zero.
+func functorToMatrix(from GraphSource, to am_graph) Graph {
+	from.Vertices(func(vertex Vertex) (terminate bool) {
+		to.ensureVertex(vertex)
+		return
+	})
+
+	switch g := to.(type) {
+	case am_ea:
+		from.Edges(func(edge Edge) (terminate bool) {
+			g.addEdges(edge)
+			return
+		})
+	case am_wea:
+		from.Edges(func(edge Edge) (terminate bool) {
+			if e, ok := edge.(WeightedEdge); ok {
+				g.addEdges(e)
+			} else {
+				u, v := edge.Both()
+				g.addEdges(NewWeightedEdge(u, v, 0))
+			}
+			return
+		})
+	default:
+		panic("Target graph did not implement a recognized adjacency matrix internal type")
+	}
+
+	return to
+}
+
+// Copies an incoming digraph into any of the implemented directed adjacency matrix
+// types. Weights are kept if the target is weighted; arcs without a weight get zero.
+func functorToDirectedMatrix(from DigraphSource, to am_graph) Graph {
+	from.Vertices(func(vertex Vertex) (terminate bool) {
+		to.ensureVertex(vertex)
+		return
+	})
+
+	switch g := to.(type) {
+	case am_dea:
+		from.Arcs(func(arc Arc) (terminate bool) {
+			g.addArcs(arc)
+			return
+		})
+	case am_dwea:
+		from.Arcs(func(arc Arc) (terminate bool) {
+			if e, ok := arc.(WeightedArc); ok {
+				g.addArcs(e)
+			} else {
+				g.addArcs(NewWeightedArc(arc.Source(), arc.Target(), 0))
+			}
+			return
+		})
+	default:
+		panic("Target graph did not implement a recognized adjacency matrix internal type")
+	}
+
+	return to
+}
@@ -0,0 +1,17 @@
+package am
+
+import (
+	"testing"
+
+	"github.com/sdboyer/gocheck"
+	"github.com/sdboyer/gogl/spec"
+)
+
+// Hook gocheck into the go test runner
+func TestHookup(t *testing.T) { gocheck.TestingT(t) }
+
+func init() {
+	for gp := range amCreators {
+		spec.SetUpTestsFromSpec(gp, G)
+	}
+}
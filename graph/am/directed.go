@@ -0,0 +1,460 @@
+package am
+
+import (
+	. "github.com/sdboyer/gogl"
+)
+
+// The core of a directed adjacency matrix. An arc from u to v fills the cell in
+// u's row and v's column; out-arcs are thus read along rows, and in-arcs down columns.
+type directed struct {
+	matrix
+}
+
+// Adds an arc from u to v, holding the provided weight if the graph is weighted,
+// unless there already is one.
+func (g *directed) connect(u, v Vertex, weight float64) {
+	g.ensureVertex(u, v)
+	i, j := g.index[u], g.index[v]
+	if g.filled(i, j) {
+		return
+	}
+
+	g.fill(i, j, weight)
+	g.size++
+}
+
+// Removes the arc from u to v, if present.
+func (g *directed) disconnect(u, v Vertex) {
+	if i, j, exists := g.indices(u, v); exists && g.filled(i, j) {
+		g.empty(i, j)
+		g.size--
+	}
+}
+
+// Removes a vertex, along with all its incident arcs.
+func (g *directed) removeVertex(vertex Vertex) {
+	if i, exists := g.index[vertex]; exists {
+		g.size -= g.rowCount(i) + g.columnCount(i)
+		if g.filled(i, i) {
+			// A loop is in both the row and the column.
+			g.size++
+		}
+		g.removeIndex(i)
+	}
+}
+
+// Fills the provided, empty core with the transpose of this one.
+func (g *directed) transposeInto(g2 *directed) {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+
+	g2.weighted = g.weighted
+	g2.ensureVertex(g.vertices...)
+	for i := range g.vertices {
+		g.eachInRow(i, func(j int) bool {
+			var weight float64
+			if g.weighted {
+				weight = g.weight(i, j)
+			}
+			g2.fill(j, i, weight)
+			return false
+		})
+	}
+	g2.size = g.size
+}
+
+// Returns the outdegree of the provided vertex. If the vertex is not present in the
+// graph, the second return value will be false.
+func (g *directed) OutDegreeOf(vertex Vertex) (degree int, exists bool) {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+
+	var i int
+	if i, exists = g.index[vertex]; exists {
+		degree = g.rowCount(i)
+	}
+	return
+}
+
+// Returns the indegree of the provided vertex. If the vertex is not present in the
+// graph, the second return value will be false.
+func (g *directed) InDegreeOf(vertex Vertex) (degree int, exists bool) {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+
+	var i int
+	if i, exists = g.index[vertex]; exists {
+		degree = g.columnCount(i)
+	}
+	return
+}
+
+// Returns the degree of the given vertex, counting both in and out-edges.
+func (g *directed) DegreeOf(vertex Vertex) (degree int, exists bool) {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+
+	var i int
+	if i, exists = g.index[vertex]; exists {
+		degree = g.rowCount(i) + g.columnCount(i)
+	}
+	return
+}
+
+// Traverses the set of edges in the graph, passing each edge to the
+// provided closure.
+func (g *directed) Edges(f EdgeStep) {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+
+	for i := range g.vertices {
+		terminate := g.eachInRow(i, func(j int) bool {
+			return f(g.edge(i, j))
+		})
+		if terminate {
+			return
+		}
+	}
+}
+
+// Traverses the set of arcs in the graph, passing each arc to the
+// provided closure.
+func (g *directed) Arcs(f ArcStep) {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+
+	for i := range g.vertices {
+		terminate := g.eachInRow(i, func(j int) bool {
+			return f(g.arc(i, j))
+		})
+		if terminate {
+			return
+		}
+	}
+}
+
+// Enumerates the set of all edges incident to the provided vertex.
+func (g *directed) IncidentTo(v Vertex, f EdgeStep) {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+
+	if i, exists := g.index[v]; exists {
+		terminate := g.eachInRow(i, func(j int) bool {
+			return f(g.arc(i, j))
+		})
+		if !terminate {
+			g.eachInColumn(i, func(j int) bool {
+				return f(g.arc(j, i))
+			})
+		}
+	}
+}
+
+// Enumerates the vertices adjacent to the provided vertex.
+func (g *directed) AdjacentTo(vertex Vertex, f VertexStep) {
+	g.IncidentTo(vertex, func(e Edge) bool {
+		u, v := e.Both()
+		if u == vertex {
+			return f(v)
+		}
+		return f(u)
+	})
+}
+
+// Enumerates the set of out-edges for the provided vertex.
+func (g *directed) ArcsFrom(v Vertex, f ArcStep) {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+
+	if i, exists := g.index[v]; exists {
+		g.eachInRow(i, func(j int) bool {
+			return f(g.arc(i, j))
+		})
+	}
+}
+
+// Enumerates the set of in-edges for the provided vertex.
+func (g *directed) ArcsTo(v Vertex, f ArcStep) {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+
+	if j, exists := g.index[v]; exists {
+		g.eachInColumn(j, func(i int) bool {
+			return f(g.arc(i, j))
+		})
+	}
+}
+
+// Enumerates the vertices to which the provided vertex has an out-arc.
+func (g *directed) SuccessorsOf(v Vertex, f VertexStep) {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+
+	if i, exists := g.index[v]; exists {
+		g.eachInRow(i, func(j int) bool {
+			return f(g.vertices[j])
+		})
+	}
+}
+
+// Enumerates the vertices from which the provided vertex has an in-arc.
+func (g *directed) PredecessorsOf(v Vertex, f VertexStep) {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+
+	if j, exists := g.index[v]; exists {
+		g.eachInColumn(j, func(i int) bool {
+			return f(g.vertices[i])
+		})
+	}
+}
+
+// Indicates whether or not the given edge is present in the graph, in either
+// direction. It matches based solely on the presence of an edge, disregarding
+// edge weight.
+//
+// This is a constant-time check.
+func (g *directed) HasEdge(edge Edge) bool {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+
+	i, j, exists := g.indices(edge.Both())
+	return exists && (g.filled(i, j) || g.filled(j, i))
+}
+
+// Indicates whether or not the given arc is present in the graph.
+//
+// This is a constant-time check.
+func (g *directed) HasArc(arc Arc) bool {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+
+	i, j, exists := g.indices(arc.Both())
+	return exists && g.filled(i, j)
+}
+
+// Indicates whether or not the given weighted edge is present in the graph,
+// in either direction, with the same weight.
+func (g *directed) hasWeightedEdge(edge WeightedEdge) bool {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+
+	i, j, exists := g.indices(edge.Both())
+	if !exists {
+		return false
+	}
+	return g.filled(i, j) && g.weight(i, j) == edge.Weight() ||
+		g.filled(j, i) && g.weight(j, i) == edge.Weight()
+}
+
+// Indicates whether or not the given weighted arc is present in the graph,
+// with the same weight.
+func (g *directed) hasWeightedArc(arc WeightedArc) bool {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+
+	i, j, exists := g.indices(arc.Both())
+	return exists && g.filled(i, j) && g.weight(i, j) == arc.Weight()
+}
+
+// Returns the density of the graph. Density is the ratio of edge count to the
+// number of edges there would be in complete graph (maximum edge count).
+func (g *directed) Density() float64 {
+	order := g.Order()
+	return float64(g.Size()) / float64(order*(order-1))
+}
+
+// The core of a mutable directed adjacency matrix, adding vertex mutation.
+type directedMut struct {
+	directed
+}
+
+// Adds the provided vertices to the graph. If a provided vertex is
+// already present in the graph, it is a no-op (for that vertex only).
+func (g *directedMut) EnsureVertex(vertices ...Vertex) {
+	if len(vertices) == 0 {
+		return
+	}
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	g.ensureVertex(vertices...)
+}
+
+// Removes a vertex from the graph. Also removes any arcs of which that
+// vertex is a member.
+func (g *directedMut) RemoveVertex(vertices ...Vertex) {
+	if len(vertices) == 0 {
+		return
+	}
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	for _, vertex := range vertices {
+		g.removeVertex(vertex)
+	}
+}
+
+/* Basic arcs */
+
+type immutableDirected struct {
+	directed
+}
+
+// Returns a graph with the same vertex and edge set, but with the
+// directionality of all its edges reversed.
+func (g *immutableDirected) Transpose() Digraph {
+	g2 := &immutableDirected{}
+	g.transposeInto(&g2.directed)
+	return g2
+}
+
+// Adds new arcs to the graph.
+func (g *immutableDirected) addArcs(arcs ...Arc) {
+	for _, arc := range arcs {
+		g.connect(arc.Source(), arc.Target(), 0)
+	}
+}
+
+type mutableDirected struct {
+	directedMut
+}
+
+// Returns a graph with the same vertex and edge set, but with the
+// directionality of all its edges reversed.
+func (g *mutableDirected) Transpose() Digraph {
+	g2 := &mutableDirected{}
+	g.transposeInto(&g2.directed)
+	return g2
+}
+
+// Adds arcs to the graph.
+func (g *mutableDirected) AddArcs(arcs ...Arc) {
+	if len(arcs) == 0 {
+		return
+	}
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	g.addArcs(arcs...)
+}
+
+// Adds new arcs to the graph.
+func (g *mutableDirected) addArcs(arcs ...Arc) {
+	for _, arc := range arcs {
+		g.connect(arc.Source(), arc.Target(), 0)
+	}
+}
+
+// Removes arcs from the graph. This does NOT remove vertex members of the
+// removed arcs.
+func (g *mutableDirected) RemoveArcs(arcs ...Arc) {
+	if len(arcs) == 0 {
+		return
+	}
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	for _, arc := range arcs {
+		g.disconnect(arc.Both())
+	}
+}
+
+/* Weighted arcs */
+
+type immutableWeightedDirected struct {
+	directed
+}
+
+// Indicates whether or not the given weighted edge is present in the graph.
+// It will only match if the provided WeightedEdge has the same weight as
+// the edge contained in the graph.
+func (g *immutableWeightedDirected) HasWeightedEdge(edge WeightedEdge) bool {
+	return g.hasWeightedEdge(edge)
+}
+
+// Indicates whether or not the given weighted arc is present in the graph.
+// It will only match if the provided WeightedArc has the same weight as
+// the arc contained in the graph.
+func (g *immutableWeightedDirected) HasWeightedArc(arc WeightedArc) bool {
+	return g.hasWeightedArc(arc)
+}
+
+// Returns a graph with the same vertex and edge set, but with the
+// directionality of all its edges reversed.
+func (g *immutableWeightedDirected) Transpose() Digraph {
+	g2 := &immutableWeightedDirected{}
+	g.transposeInto(&g2.directed)
+	return g2
+}
+
+// Adds new arcs to the graph.
+func (g *immutableWeightedDirected) addArcs(arcs ...WeightedArc) {
+	for _, arc := range arcs {
+		g.connect(arc.Source(), arc.Target(), arc.Weight())
+	}
+}
+
+type weightedDirected struct {
+	directedMut
+}
+
+// Indicates whether or not the given weighted edge is present in the graph.
+// It will only match if the provided WeightedEdge has the same weight as
+// the edge contained in the graph.
+func (g *weightedDirected) HasWeightedEdge(edge WeightedEdge) bool {
+	return g.hasWeightedEdge(edge)
+}
+
+// Indicates whether or not the given weighted arc is present in the graph.
+// It will only match if the provided WeightedArc has the same weight as
+// the arc contained in the graph.
+func (g *weightedDirected) HasWeightedArc(arc WeightedArc) bool {
+	return g.hasWeightedArc(arc)
+}
+
+// Returns a graph with the same vertex and edge set, but with the
+// directionality of all its edges reversed.
+func (g *weightedDirected) Transpose() Digraph {
+	g2 := &weightedDirected{}
+	g.transposeInto(&g2.directed)
+	return g2
+}
+
+// Adds arcs to the graph.
+func (g *weightedDirected) AddArcs(arcs ...WeightedArc) {
+	if len(arcs) == 0 {
+		return
+	}
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	g.addArcs(arcs...)
+}
+
+// Adds new arcs to the graph.
+func (g *weightedDirected) addArcs(arcs ...WeightedArc) {
+	for _, arc := range arcs {
+		g.connect(arc.Source(), arc.Target(), arc.Weight())
+	}
+}
+
+// Removes arcs from the graph, regardless of their weight. This does NOT remove
+// vertex members of the removed arcs.
+func (g *weightedDirected) RemoveArcs(arcs ...WeightedArc) {
+	if len(arcs) == 0 {
+		return
+	}
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	for _, arc := range arcs {
+		g.disconnect(arc.Both())
+	}
+}
@@ -0,0 +1,278 @@
+package am
+
+import (
+	"math/bits"
+	"sync"
+
+	. "github.com/sdboyer/gogl"
+)
+
+// The number of vertices by which a matrix's rows are padded and initially sized.
+// Rows are always a whole number of words long, so each begins on a word boundary.
+const wordSize = 64
+
+// A bitset holding one bit for each cell of a matrix.
+type bitset []uint64
+
+func (b bitset) has(i int) bool {
+	return b[i/wordSize]&(1<<uint(i%wordSize)) != 0
+}
+
+func (b bitset) set(i int) {
+	b[i/wordSize] |= 1 << uint(i%wordSize)
+}
+
+func (b bitset) clear(i int) {
+	b[i/wordSize] &^= 1 << uint(i%wordSize)
+}
+
+// Contains the vertex set and adjacency matrix shared by all implementations.
+//
+// Each vertex is assigned a dense index, its row and column in the matrix. The
+// matrix is held in a bitset, stride cells to a row; when the vertex set outgrows
+// it, the matrix is reallocated with double the stride. Removing a vertex moves
+// the last vertex into its index, so that indices remain dense.
+//
+// Weighted matrices additionally hold the weight of each cell in a slice laid out
+// the same way as the bitset.
+type matrix struct {
+	index    map[Vertex]int
+	vertices []Vertex
+	stride   int
+	cells    bitset
+	weights  []float64
+	weighted bool
+	size     int
+	mu       sync.RWMutex
+}
+
+// Returns the position of the cell at row i, column j.
+func (m *matrix) cell(i, j int) int {
+	return i*m.stride + j
+}
+
+// Indicates whether or not the given vertex is present in the graph.
+func (m *matrix) hasVertex(vertex Vertex) (exists bool) {
+	_, exists = m.index[vertex]
+	return
+}
+
+// Returns the indices of the provided vertices, and whether both are present.
+func (m *matrix) indices(u, v Vertex) (i, j int, exists bool) {
+	if i, exists = m.index[u]; exists {
+		j, exists = m.index[v]
+	}
+	return
+}
+
+// Indicates whether or not the cell at row i, column j is filled.
+func (m *matrix) filled(i, j int) bool {
+	return m.cells.has(m.cell(i, j))
+}
+
+// Returns the weight held in the cell at row i, column j.
+func (m *matrix) weight(i, j int) float64 {
+	return m.weights[m.cell(i, j)]
+}
+
+// Fills the cell at row i, column j, holding the provided weight if the matrix
+// is weighted.
+func (m *matrix) fill(i, j int, weight float64) {
+	c := m.cell(i, j)
+	m.cells.set(c)
+	if m.weighted {
+		m.weights[c] = weight
+	}
+}
+
+// Empties the cell at row i, column j.
+func (m *matrix) empty(i, j int) {
+	c := m.cell(i, j)
+	m.cells.clear(c)
+	if m.weighted {
+		m.weights[c] = 0
+	}
+}
+
+// Returns the edge held in the cell at row i, column j.
+func (m *matrix) edge(i, j int) Edge {
+	if m.weighted {
+		return NewWeightedEdge(m.vertices[i], m.vertices[j], m.weight(i, j))
+	}
+	return NewEdge(m.vertices[i], m.vertices[j])
+}
+
+// Returns the arc held in the cell at row i, column j.
+func (m *matrix) arc(i, j int) Arc {
+	if m.weighted {
+		return NewWeightedArc(m.vertices[i], m.vertices[j], m.weight(i, j))
+	}
+	return NewArc(m.vertices[i], m.vertices[j])
+}
+
+// Passes the column of each filled cell in row i to the provided closure. Returns
+// true if the closure terminated the traversal early.
+func (m *matrix) eachInRow(i int, f func(j int) bool) bool {
+	words := m.stride / wordSize
+	for w, word := range m.cells[i*words : (i+1)*words] {
+		for ; word != 0; word &= word - 1 {
+			if f(w*wordSize + bits.TrailingZeros64(word)) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// Passes the row of each filled cell in column j to the provided closure. Returns
+// true if the closure terminated the traversal early.
+func (m *matrix) eachInColumn(j int, f func(i int) bool) bool {
+	for i := range m.vertices {
+		if m.filled(i, j) && f(i) {
+			return true
+		}
+	}
+	return false
+}
+
+// Counts the filled cells in row i.
+func (m *matrix) rowCount(i int) (n int) {
+	words := m.stride / wordSize
+	for _, word := range m.cells[i*words : (i+1)*words] {
+		n += bits.OnesCount64(word)
+	}
+	return
+}
+
+// Counts the filled cells in column j.
+func (m *matrix) columnCount(j int) (n int) {
+	for i := range m.vertices {
+		if m.filled(i, j) {
+			n++
+		}
+	}
+	return
+}
+
+// Adds the provided vertices to the graph. If a provided vertex is
+// already present in the graph, it is a no-op (for that vertex only).
+func (m *matrix) ensureVertex(vertices ...Vertex) {
+	if m.index == nil {
+		m.index = make(map[Vertex]int)
+	}
+
+	for _, vertex := range vertices {
+		if !m.hasVertex(vertex) {
+			if len(m.vertices) == m.stride {
+				m.grow()
+			}
+			m.index[vertex] = len(m.vertices)
+			m.vertices = append(m.vertices, vertex)
+		}
+	}
+}
+
+// Doubles the stride of the matrix, copying each row into its new position.
+func (m *matrix) grow() {
+	stride := 2 * m.stride
+	if stride == 0 {
+		stride = wordSize
+	}
+
+	cells := make(bitset, stride*stride/wordSize)
+	words, newWords := m.stride/wordSize, stride/wordSize
+	for i := range m.vertices {
+		copy(cells[i*newWords:], m.cells[i*words:(i+1)*words])
+	}
+
+	if m.weighted {
+		weights := make([]float64, stride*stride)
+		for i := range m.vertices {
+			copy(weights[i*stride:], m.weights[i*m.stride:(i+1)*m.stride])
+		}
+		m.weights = weights
+	}
+
+	m.cells, m.stride = cells, stride
+}
+
+// Moves the contents of the cell at row i, column j to row k, column l.
+func (m *matrix) move(i, j, k, l int) {
+	if !m.filled(i, j) {
+		m.empty(k, l)
+		return
+	}
+
+	var weight float64
+	if m.weighted {
+		weight = m.weight(i, j)
+	}
+	m.empty(i, j)
+	m.fill(k, l, weight)
+}
+
+// Removes the vertex at index i from the matrix, along with any filled cells in its
+// row or column. The caller is responsible for keeping the graph's size correct.
+func (m *matrix) removeIndex(i int) {
+	last := len(m.vertices) - 1
+	for k := 0; k <= last; k++ {
+		m.empty(i, k)
+		m.empty(k, i)
+	}
+
+	// Fill the hole with the last vertex, keeping indices dense.
+	removed := m.vertices[i]
+	if i != last {
+		for k := 0; k < last; k++ {
+			if k != i {
+				m.move(last, k, i, k)
+				m.move(k, last, k, i)
+			}
+		}
+		m.move(last, last, i, i)
+
+		m.vertices[i] = m.vertices[last]
+		m.index[m.vertices[i]] = i
+	}
+
+	delete(m.index, removed)
+	m.vertices[last] = nil
+	m.vertices = m.vertices[:last]
+}
+
+// Traverses the graph's vertices in index order, passing each vertex to the
+// provided closure.
+func (m *matrix) Vertices(f VertexStep) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	for _, v := range m.vertices {
+		if f(v) {
+			return
+		}
+	}
+}
+
+// Indicates whether or not the given vertex is present in the graph.
+func (m *matrix) HasVertex(vertex Vertex) bool {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	return m.hasVertex(vertex)
+}
+
+// Returns the order (number of vertices) in the graph.
+func (m *matrix) Order() int {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	return len(m.vertices)
+}
+
+// Returns the size (number of edges) in the graph.
+func (m *matrix) Size() int {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	return m.size
+}
@@ -0,0 +1,124 @@
+package am
+
+import (
+	stdrand "math/rand"
+
+	. "github.com/sdboyer/gocheck"
+	. "github.com/sdboyer/gogl"
+	"github.com/sdboyer/gogl/graph/al"
+)
+
+type MatrixSuite struct{}
+
+var _ = Suite(&MatrixSuite{})
+
+func (s *MatrixSuite) TestCreate(c *C) {
+	specs := map[GraphSpec]interface{}{
+		Spec():                                   &mutableUndirected{},
+		Spec().Directed():                        &mutableDirected{},
+		Spec().Weighted():                        &weightedUndirected{},
+		Spec().Directed().Weighted():             &weightedDirected{},
+		Spec().Immutable():                       &immutableUndirected{},
+		Spec().Immutable().Directed():            &immutableDirected{},
+		Spec().Immutable().Weighted():            &immutableWeightedUndirected{},
+		Spec().Immutable().Directed().Weighted(): &immutableWeightedDirected{},
+	}
+	for gs, expected := range specs {
+		c.Assert(gs.Create(G), FitsTypeOf, expected)
+	}
+
+	c.Assert(func() { Spec().Labeled().Create(G) }, PanicMatches, "No graph implementation found for spec")
+	c.Assert(func() { Spec().Loop().Create(G) }, PanicMatches, "No graph implementation found for spec")
+}
+
+type mutableWeightedDigraph interface {
+	WeightedDigraph
+	VertexSetMutator
+	WeightedArcSetMutator
+}
+
+// Checks that the arcs of two digraphs are the same, weights included.
+func sameArcs(c *C, g, ref WeightedDigraph) {
+	c.Assert(Order(g), Equals, Order(ref))
+	c.Assert(Size(g), Equals, Size(ref))
+
+	var n int
+	g.Arcs(func(a Arc) (terminate bool) {
+		c.Assert(ref.HasWeightedArc(a.(WeightedArc)), Equals, true, Commentf("arc %v", a))
+		n++
+		return
+	})
+	c.Assert(n, Equals, Size(ref))
+
+	ref.Vertices(func(v Vertex) (terminate bool) {
+		in, _ := g.InDegreeOf(v)
+		out, _ := g.OutDegreeOf(v)
+		refin, _ := ref.InDegreeOf(v)
+		refout, _ := ref.OutDegreeOf(v)
+		c.Assert(in, Equals, refin)
+		c.Assert(out, Equals, refout)
+		return
+	})
+}
+
+// Grows the matrix past its initial stride and removes vertices from all over it,
+// comparing against an adjacency list subjected to the same changes.
+func (s *MatrixSuite) TestGrowAndRemove(c *C) {
+	g := Spec().Directed().Weighted().Create(G).(mutableWeightedDigraph)
+	ref := Spec().Directed().Weighted().Create(al.G).(mutableWeightedDigraph)
+
+	r := stdrand.New(stdrand.NewSource(1))
+	for i := 0; i < 3000; i++ {
+		u, v := r.Intn(150), r.Intn(150)
+		switch r.Intn(10) {
+		case 0:
+			g.RemoveVertex(u)
+			ref.RemoveVertex(u)
+		case 1, 2:
+			g.RemoveArcs(NewWeightedArc(u, v, 0))
+			ref.RemoveArcs(NewWeightedArc(u, v, 0))
+		default:
+			w := float64(r.Intn(100))
+			g.AddArcs(NewWeightedArc(u, v, w))
+			ref.AddArcs(NewWeightedArc(u, v, w))
+		}
+	}
+	sameArcs(c, g, ref)
+
+	tg := g.Transpose().(WeightedDigraph)
+	c.Assert(Size(tg), Equals, Size(ref))
+	c.Assert(Order(tg), Equals, Order(ref))
+	ref.Arcs(func(a Arc) (terminate bool) {
+		w := a.(WeightedArc).Weight()
+		c.Assert(tg.HasWeightedArc(NewWeightedArc(a.Target(), a.Source(), w)), Equals, true)
+		return
+	})
+
+	// removing every vertex exercises both the last index and the others
+	for i, v := range CollectVertices(ref) {
+		g.RemoveVertex(v)
+		ref.RemoveVertex(v)
+		if i%20 == 0 {
+			sameArcs(c, g, ref)
+		}
+	}
+	c.Assert(Order(g), Equals, 0)
+	c.Assert(Size(g), Equals, 0)
+}
+
+func (s *MatrixSuite) TestUndirectedRemove(c *C) {
+	g := Spec().Create(G).(MutableGraph)
+	g.AddEdges(NewEdge(1, 2), NewEdge(2, 3), NewEdge(3, 1), NewEdge(3, 3), NewEdge(3, 4))
+
+	g.RemoveVertex(1)
+	c.Assert(Size(g), Equals, 3)
+	c.Assert(g.HasEdge(NewEdge(4, 3)), Equals, true)
+	c.Assert(g.HasEdge(NewEdge(3, 3)), Equals, true)
+	c.Assert(g.HasEdge(NewEdge(2, 3)), Equals, true)
+	c.Assert(g.HasEdge(NewEdge(1, 2)), Equals, false)
+
+	g.RemoveVertex(3)
+	c.Assert(Size(g), Equals, 0)
+	c.Assert(Order(g), Equals, 2)
+	c.Assert(g.HasVertex(4), Equals, true)
+}
@@ -0,0 +1,278 @@
+package am
+
+import (
+	. "github.com/sdboyer/gogl"
+)
+
+// The core of an undirected adjacency matrix. The matrix is kept symmetric: an
+// edge between u and v fills the cells at both (u, v) and (v, u).
+type undirected struct {
+	matrix
+}
+
+// Adds an edge between u and v, holding the provided weight if the graph is
+// weighted, unless the two are already connected.
+func (g *undirected) connect(u, v Vertex, weight float64) {
+	g.ensureVertex(u, v)
+	i, j := g.index[u], g.index[v]
+	if g.filled(i, j) {
+		return
+	}
+
+	g.fill(i, j, weight)
+	g.fill(j, i, weight)
+	g.size++
+}
+
+// Removes the edge between u and v, if present.
+func (g *undirected) disconnect(u, v Vertex) {
+	if i, j, exists := g.indices(u, v); exists && g.filled(i, j) {
+		g.empty(i, j)
+		g.empty(j, i)
+		g.size--
+	}
+}
+
+// Removes a vertex, along with all its incident edges.
+func (g *undirected) removeVertex(vertex Vertex) {
+	if i, exists := g.index[vertex]; exists {
+		g.size -= g.rowCount(i)
+		g.removeIndex(i)
+	}
+}
+
+// Returns the degree of the provided vertex. If the vertex is not present in the
+// graph, the second return value will be false.
+func (g *undirected) DegreeOf(vertex Vertex) (degree int, exists bool) {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+
+	var i int
+	if i, exists = g.index[vertex]; exists {
+		degree = g.rowCount(i)
+	}
+	return
+}
+
+// Traverses the set of edges in the graph, passing each edge to the
+// provided closure.
+func (g *undirected) Edges(f EdgeStep) {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+
+	// Only the lower triangle of the matrix need be visited.
+	for i := range g.vertices {
+		terminate := g.eachInRow(i, func(j int) bool {
+			return j <= i && f(g.edge(i, j))
+		})
+		if terminate {
+			return
+		}
+	}
+}
+
+// Enumerates the set of all edges incident to the provided vertex.
+func (g *undirected) IncidentTo(v Vertex, f EdgeStep) {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+
+	if i, exists := g.index[v]; exists {
+		g.eachInRow(i, func(j int) bool {
+			return f(g.edge(i, j))
+		})
+	}
+}
+
+// Enumerates the vertices adjacent to the provided vertex.
+func (g *undirected) AdjacentTo(vertex Vertex, f VertexStep) {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+
+	if i, exists := g.index[vertex]; exists {
+		g.eachInRow(i, func(j int) bool {
+			return f(g.vertices[j])
+		})
+	}
+}
+
+// Indicates whether or not the given edge is present in the graph. It matches
+// based solely on the presence of an edge, disregarding edge weight.
+//
+// This is a constant-time check.
+func (g *undirected) HasEdge(edge Edge) bool {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+
+	i, j, exists := g.indices(edge.Both())
+	return exists && g.filled(i, j)
+}
+
+// Indicates whether or not the given weighted edge is present in the graph,
+// with the same weight.
+func (g *undirected) hasWeightedEdge(edge WeightedEdge) bool {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+
+	i, j, exists := g.indices(edge.Both())
+	return exists && g.filled(i, j) && g.weight(i, j) == edge.Weight()
+}
+
+// Returns the density of the graph. Density is the ratio of edge count to the
+// number of edges there would be in complete graph (maximum edge count).
+func (g *undirected) Density() float64 {
+	order := g.Order()
+	return 2 * float64(g.Size()) / float64(order*(order-1))
+}
+
+// The core of a mutable undirected adjacency matrix, adding vertex mutation.
+type undirectedMut struct {
+	undirected
+}
+
+// Adds the provided vertices to the graph. If a provided vertex is
+// already present in the graph, it is a no-op (for that vertex only).
+func (g *undirectedMut) EnsureVertex(vertices ...Vertex) {
+	if len(vertices) == 0 {
+		return
+	}
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	g.ensureVertex(vertices...)
+}
+
+// Removes a vertex from the graph. Also removes any edges of which that
+// vertex is a member.
+func (g *undirectedMut) RemoveVertex(vertices ...Vertex) {
+	if len(vertices) == 0 {
+		return
+	}
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	for _, vertex := range vertices {
+		g.removeVertex(vertex)
+	}
+}
+
+/* Basic edges */
+
+type immutableUndirected struct {
+	undirected
+}
+
+// Adds new edges to the graph.
+func (g *immutableUndirected) addEdges(edges ...Edge) {
+	for _, edge := range edges {
+		u, v := edge.Both()
+		g.connect(u, v, 0)
+	}
+}
+
+type mutableUndirected struct {
+	undirectedMut
+}
+
+// Adds edges to the graph.
+func (g *mutableUndirected) AddEdges(edges ...Edge) {
+	if len(edges) == 0 {
+		return
+	}
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	g.addEdges(edges...)
+}
+
+// Adds new edges to the graph.
+func (g *mutableUndirected) addEdges(edges ...Edge) {
+	for _, edge := range edges {
+		u, v := edge.Both()
+		g.connect(u, v, 0)
+	}
+}
+
+// Removes edges from the graph. This does NOT remove vertex members of the
+// removed edges.
+func (g *mutableUndirected) RemoveEdges(edges ...Edge) {
+	if len(edges) == 0 {
+		return
+	}
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	for _, edge := range edges {
+		g.disconnect(edge.Both())
+	}
+}
+
+/* Weighted edges */
+
+type immutableWeightedUndirected struct {
+	undirected
+}
+
+// Indicates whether or not the given weighted edge is present in the graph.
+// It will only match if the provided WeightedEdge has the same weight as
+// the edge contained in the graph.
+func (g *immutableWeightedUndirected) HasWeightedEdge(edge WeightedEdge) bool {
+	return g.hasWeightedEdge(edge)
+}
+
+// Adds new edges to the graph.
+func (g *immutableWeightedUndirected) addEdges(edges ...WeightedEdge) {
+	for _, edge := range edges {
+		u, v := edge.Both()
+		g.connect(u, v, edge.Weight())
+	}
+}
+
+type weightedUndirected struct {
+	undirectedMut
+}
+
+// Indicates whether or not the given weighted edge is present in the graph.
+// It will only match if the provided WeightedEdge has the same weight as
+// the edge contained in the graph.
+func (g *weightedUndirected) HasWeightedEdge(edge WeightedEdge) bool {
+	return g.hasWeightedEdge(edge)
+}
+
+// Adds edges to the graph.
+func (g *weightedUndirected) AddEdges(edges ...WeightedEdge) {
+	if len(edges) == 0 {
+		return
+	}
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	g.addEdges(edges...)
+}
+
+// Adds new edges to the graph.
+func (g *weightedUndirected) addEdges(edges ...WeightedEdge) {
+	for _, edge := range edges {
+		u, v := edge.Both()
+		g.connect(u, v, edge.Weight())
+	}
+}
+
+// Removes edges from the graph, regardless of their weight. This does NOT remove
+// vertex members of the removed edges.
+func (g *weightedUndirected) RemoveEdges(edges ...WeightedEdge) {
+	if len(edges) == 0 {
+		return
+	}
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	for _, edge := range edges {
+		g.disconnect(edge.Both())
+	}
+}
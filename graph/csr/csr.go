@@ -0,0 +1,329 @@
+package csr
+
+import (
+	"math"
+	"sort"
+
+	. "github.com/sdboyer/gogl"
+)
+
+/*
+Compressed sparse row (CSR) graphs are immutable graphs laid out for compactness
+and locality, suited to large graphs that are built once and then only read.
+
+Each vertex is mapped to a dense integer ID. The vertices adjacent to each are held
+as a sorted run of IDs within a single, contiguous slice, and a second slice holds
+the offset at which each vertex's run begins; weighted graphs hold a third slice of
+weights, parallel to the first. There are no per-vertex maps and no pointers to
+chase, so the memory cost for the entire graph G is one map entry per vertex, plus
+a few machine words per vertex and a single int32 per edge (twice that, for
+undirected graphs, which hold each edge at both ends). Checking for an edge is a
+binary search of the source vertex's run.
+
+Directed CSR graphs hold a second set of runs, indexed by target, so that in-arcs
+are as cheap to reach as out-arcs; transposing one simply swaps the two, sharing
+all memory with the original.
+
+Only immutable simple graphs with basic or weighted edges are implemented. They
+are populated from the GraphSource given to their GraphSpec, whose vertices and
+edges are each enumerated twice during construction.
+*/
+
+var csrCreators = map[GraphProperties]func(GraphSource) Graph{
+	GraphProperties(G_IMMUTABLE | G_UNDIRECTED | G_BASIC | G_SIMPLE): func(src GraphSource) Graph {
+		return &undirected{build(src, false, false)}
+	},
+	GraphProperties(G_IMMUTABLE | G_DIRECTED | G_BASIC | G_SIMPLE): func(src GraphSource) Graph {
+		return &basicDirected{newDirected(build(src, true, false))}
+	},
+	GraphProperties(G_IMMUTABLE | G_UNDIRECTED | G_WEIGHTED | G_SIMPLE): func(src GraphSource) Graph {
+		return &weightedUndirected{undirected{build(src, false, true)}}
+	},
+	GraphProperties(G_IMMUTABLE | G_DIRECTED | G_WEIGHTED | G_SIMPLE): func(src GraphSource) Graph {
+		return &weightedDirected{newDirected(build(src, true, true))}
+	},
+}
+
+// Create an immutable graph in the compressed sparse row style from the provided
+// GraphSpec, populated from the GraphSpec's GraphSource. If there is no source, the
+// graph is empty.
+//
+// If the GraphSpec indicates a graph type that is not currently implemented, this
+// function will panic. Note that this includes all mutable graph types.
+func G(gs GraphSpec) Graph {
+	gf, exists := csrCreators[gs.Props]
+	if !exists {
+		panic("No graph implementation found for spec")
+	}
+
+	if gs.Source != nil && gs.Props&G_DIRECTED == G_DIRECTED {
+		if _, ok := gs.Source.(DigraphSource); !ok {
+			panic("Cannot create a digraph from a graph.")
+		}
+	}
+	return gf(gs.Source)
+}
+
+// A set of sorted runs of vertex IDs, one for each vertex.
+type rows struct {
+	offsets []int // the run for vertex i is targets[offsets[i]:offsets[i+1]]
+	targets []int32
+	weights []float64 // parallel to targets; nil if the graph is unweighted
+}
+
+// Returns the bounds of the run for vertex i.
+func (r *rows) bounds(i int32) (start, end int) {
+	return r.offsets[i], r.offsets[i+1]
+}
+
+// Returns the number of IDs in the run for vertex i.
+func (r *rows) degree(i int32) int {
+	return r.offsets[i+1] - r.offsets[i]
+}
+
+// Returns the position of j in the run for vertex i, and whether it is present.
+func (r *rows) find(i, j int32) (pos int, exists bool) {
+	start, end := r.bounds(i)
+	pos = start + sort.Search(end-start, func(k int) bool {
+		return r.targets[start+k] >= j
+	})
+	return pos, pos < end && r.targets[pos] == j
+}
+
+// Returns the rows with every run reversed: the run for j holds i wherever the run
+// for i held j. Because runs are filled in order of i, the results are sorted.
+func (r *rows) transpose() rows {
+	n := len(r.offsets) - 1
+	t := rows{offsets: make([]int, n+1), targets: make([]int32, len(r.targets))}
+	if r.weights != nil {
+		t.weights = make([]float64, len(r.weights))
+	}
+
+	for _, j := range r.targets {
+		t.offsets[j+1]++
+	}
+	for i := 0; i < n; i++ {
+		t.offsets[i+1] += t.offsets[i]
+	}
+
+	cursor := make([]int, n)
+	copy(cursor, t.offsets)
+	for i := 0; i < n; i++ {
+		for pos := r.offsets[i]; pos < r.offsets[i+1]; pos++ {
+			j := r.targets[pos]
+			t.targets[cursor[j]] = int32(i)
+			if t.weights != nil {
+				t.weights[cursor[j]] = r.weights[pos]
+			}
+			cursor[j]++
+		}
+	}
+	return t
+}
+
+// Sorts a run by ID, along with its weights. The sort is stable, so that of several
+// entries with the same ID, the first one filled remains first.
+type runSorter struct {
+	targets []int32
+	weights []float64
+}
+
+func (s runSorter) Len() int           { return len(s.targets) }
+func (s runSorter) Less(a, b int) bool { return s.targets[a] < s.targets[b] }
+func (s runSorter) Swap(a, b int) {
+	s.targets[a], s.targets[b] = s.targets[b], s.targets[a]
+	if s.weights != nil {
+		s.weights[a], s.weights[b] = s.weights[b], s.weights[a]
+	}
+}
+
+// Contains the vertex set shared by all implementations, and the mapping between
+// vertices and their IDs.
+type base struct {
+	ids      map[Vertex]int32
+	vertices []Vertex
+	adj      rows // out-arcs, for digraphs
+	size     int
+}
+
+// Returns the ID for the provided vertex, assigning the next one if it has none.
+func (g *base) id(v Vertex) int32 {
+	id, exists := g.ids[v]
+	if !exists {
+		if len(g.vertices) == math.MaxInt32 {
+			panic("Too many vertices for a CSR graph.")
+		}
+		id = int32(len(g.vertices))
+		g.ids[v] = id
+		g.vertices = append(g.vertices, v)
+	}
+	return id
+}
+
+// Builds the vertex set and adjacency rows from the provided source. For undirected
+// graphs, each edge is entered in the rows of both its vertices. As with the other
+// simple graph implementations, of several edges connecting the same vertices, only
+// the first is kept.
+func build(src GraphSource, directed, weighted bool) base {
+	g := base{ids: make(map[Vertex]int32)}
+
+	// The enumerator passes each edge's endpoints, and its weight if it has one.
+	each := func(f func(u, v Vertex, weight float64)) {}
+	if src != nil {
+		each = func(f func(u, v Vertex, weight float64)) {
+			step := func(e Edge) (terminate bool) {
+				u, v := e.Both()
+				var weight float64
+				if we, ok := e.(WeightedEdge); ok && weighted {
+					weight = we.Weight()
+				}
+				f(u, v, weight)
+				return
+			}
+
+			if directed {
+				src.(DigraphSource).Arcs(func(a Arc) bool {
+					return step(a)
+				})
+			} else {
+				src.Edges(step)
+			}
+		}
+
+		src.Vertices(func(v Vertex) (terminate bool) {
+			g.id(v)
+			return
+		})
+	}
+
+	// First, count the entries in each row, assigning IDs to any vertices not yet seen.
+	var counts []int
+	each(func(u, v Vertex, _ float64) {
+		i, j := g.id(u), g.id(v)
+		for len(counts) < len(g.vertices) {
+			counts = append(counts, 0)
+		}
+		counts[i]++
+		if !directed && i != j {
+			counts[j]++
+		}
+	})
+
+	n := len(g.vertices)
+	g.adj.offsets = make([]int, n+1)
+	for i, c := range counts {
+		g.adj.offsets[i+1] = c
+	}
+	for i := 0; i < n; i++ {
+		g.adj.offsets[i+1] += g.adj.offsets[i]
+	}
+
+	// Then fill them.
+	g.adj.targets = make([]int32, g.adj.offsets[n])
+	if weighted {
+		g.adj.weights = make([]float64, g.adj.offsets[n])
+	}
+	cursor := make([]int, n)
+	copy(cursor, g.adj.offsets)
+	enter := func(i, j int32, weight float64) {
+		g.adj.targets[cursor[i]] = j
+		if weighted {
+			g.adj.weights[cursor[i]] = weight
+		}
+		cursor[i]++
+	}
+	each(func(u, v Vertex, weight float64) {
+		i, j := g.ids[u], g.ids[v]
+		enter(i, j, weight)
+		if !directed && i != j {
+			enter(j, i, weight)
+		}
+	})
+
+	g.compact(directed)
+	return g
+}
+
+// Sorts each row and removes duplicate entries from it, closing up the gaps, then
+// counts the edges that remain.
+func (g *base) compact(directed bool) {
+	r := &g.adj
+	n := len(r.offsets) - 1
+
+	var w int
+	for i := 0; i < n; i++ {
+		start, end := r.offsets[i], r.offsets[i+1]
+		s := runSorter{targets: r.targets[start:end]}
+		if r.weights != nil {
+			s.weights = r.weights[start:end]
+		}
+		sort.Stable(s)
+
+		r.offsets[i] = w
+		for pos := start; pos < end; pos++ {
+			if pos > start && r.targets[pos] == r.targets[pos-1] {
+				continue
+			}
+
+			r.targets[w] = r.targets[pos]
+			if r.weights != nil {
+				r.weights[w] = r.weights[pos]
+			}
+			// Undirected edges appear in two rows (or, for loops, one); count them once.
+			if directed || r.targets[w] >= int32(i) {
+				g.size++
+			}
+			w++
+		}
+	}
+
+	r.offsets[n] = w
+	r.targets = r.targets[:w:w]
+	if r.weights != nil {
+		r.weights = r.weights[:w:w]
+	}
+}
+
+// Traverses the graph's vertices in ID order, passing each vertex to the
+// provided closure.
+func (g *base) Vertices(f VertexStep) {
+	for _, v := range g.vertices {
+		if f(v) {
+			return
+		}
+	}
+}
+
+// Indicates whether or not the given vertex is present in the graph.
+func (g *base) HasVertex(vertex Vertex) bool {
+	_, exists := g.ids[vertex]
+	return exists
+}
+
+// Returns the order (number of vertices) in the graph.
+func (g *base) Order() int {
+	return len(g.vertices)
+}
+
+// Returns the size (number of edges) in the graph.
+func (g *base) Size() int {
+	return g.size
+}
+
+// Returns the IDs of the provided vertices, and whether both are present.
+func (g *base) pair(u, v Vertex) (i, j int32, exists bool) {
+	if i, exists = g.ids[u]; exists {
+		j, exists = g.ids[v]
+	}
+	return
+}
+
+// Returns the position of the entry from u to v in the provided rows, and whether
+// there is one.
+func (g *base) locate(r *rows, u, v Vertex) (pos int, exists bool) {
+	i, j, exists := g.pair(u, v)
+	if !exists {
+		return
+	}
+	return r.find(i, j)
+}
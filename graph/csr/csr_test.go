@@ -0,0 +1,132 @@
+package csr
+
+import (
+	stdrand "math/rand"
+	"testing"
+
+	. "github.com/sdboyer/gocheck"
+	. "github.com/sdboyer/gogl"
+	"github.com/sdboyer/gogl/graph/al"
+	"github.com/sdboyer/gogl/spec"
+)
+
+// Hook gocheck into the go test runner
+func TestHookup(t *testing.T) { TestingT(t) }
+
+func init() {
+	for gp := range csrCreators {
+		spec.SetUpTestsFromSpec(gp, G)
+	}
+}
+
+type CSRSuite struct{}
+
+var _ = Suite(&CSRSuite{})
+
+func (s *CSRSuite) TestCreate(c *C) {
+	specs := map[GraphSpec]interface{}{
+		Spec().Immutable():                       &undirected{},
+		Spec().Immutable().Directed():            &basicDirected{},
+		Spec().Immutable().Weighted():            &weightedUndirected{},
+		Spec().Immutable().Directed().Weighted(): &weightedDirected{},
+	}
+	for gs, expected := range specs {
+		c.Assert(gs.Create(G), FitsTypeOf, expected)
+	}
+
+	c.Assert(func() { Spec().Create(G) }, PanicMatches, "No graph implementation found for spec")
+	c.Assert(func() {
+		Spec().Immutable().Directed().Using(EdgeList{NewEdge(1, 2)}).Create(G)
+	}, PanicMatches, "Cannot create a digraph from a graph.")
+}
+
+// A source whose vertex enumeration omits some of the vertices in its edges.
+type partialSource struct {
+	WeightedArcList
+	vertices []Vertex
+}
+
+func (s partialSource) Vertices(f VertexStep) {
+	for _, v := range s.vertices {
+		if f(v) {
+			return
+		}
+	}
+}
+
+func (s *CSRSuite) TestBuild(c *C) {
+	src := partialSource{
+		WeightedArcList{
+			NewWeightedArc("a", "b", 1),
+			NewWeightedArc("b", "a", 2),
+			NewWeightedArc("a", "b", 3),
+			NewWeightedArc("c", "c", 4),
+			NewWeightedArc("b", "d", 5),
+		},
+		[]Vertex{"isolate", "a"},
+	}
+
+	// the first of any duplicate edges is the one kept
+	g := Spec().Immutable().Weighted().Using(src).Create(G).(WeightedGraph)
+	c.Assert(Order(g), Equals, 5)
+	c.Assert(Size(g), Equals, 3)
+	c.Assert(g.HasWeightedEdge(NewWeightedEdge("b", "a", 1)), Equals, true)
+	c.Assert(g.HasWeightedEdge(NewWeightedEdge("a", "b", 2)), Equals, false)
+	c.Assert(g.HasEdge(NewEdge("c", "c")), Equals, true)
+	degree, exists := g.DegreeOf("isolate")
+	c.Assert(degree, Equals, 0)
+	c.Assert(exists, Equals, true)
+
+	dg := Spec().Immutable().Directed().Weighted().Using(src).Create(G).(WeightedDigraph)
+	c.Assert(Size(dg), Equals, 4)
+	c.Assert(dg.HasWeightedArc(NewWeightedArc("a", "b", 1)), Equals, true)
+	c.Assert(dg.HasWeightedArc(NewWeightedArc("b", "a", 2)), Equals, true)
+
+	in, _ := dg.InDegreeOf("c")
+	out, _ := dg.OutDegreeOf("c")
+	c.Assert(in, Equals, 1)
+	c.Assert(out, Equals, 1)
+
+	// the transpose shares the original's memory, with the roles of its rows swapped
+	tg := dg.Transpose().(*weightedDirected)
+	c.Assert(&tg.adj.targets[0], Equals, &dg.(*weightedDirected).in.targets[0])
+	c.Assert(tg.HasWeightedArc(NewWeightedArc("d", "b", 5)), Equals, true)
+	tg.ArcsTo("d", func(a Arc) (terminate bool) {
+		c.Fatalf("unexpected in-arc %v", a)
+		return
+	})
+}
+
+// Builds the same random graphs as CSR graphs and adjacency lists, and compares them.
+func (s *CSRSuite) TestMatchesAdjacencyList(c *C) {
+	r := stdrand.New(stdrand.NewSource(1))
+	var el WeightedArcList
+	for i := 0; i < 2000; i++ {
+		el = append(el, NewWeightedArc(r.Intn(200), r.Intn(200), float64(r.Intn(10))))
+	}
+
+	for _, gs := range []GraphSpec{
+		Spec().Immutable().Weighted().Using(el),
+		Spec().Immutable().Directed().Weighted().Using(el),
+	} {
+		g := gs.Create(G).(WeightedGraph)
+		ref := gs.Create(al.G).(WeightedGraph)
+		c.Assert(Order(g), Equals, Order(ref))
+		c.Assert(Size(g), Equals, Size(ref))
+
+		var n int
+		g.Edges(func(e Edge) (terminate bool) {
+			c.Assert(ref.HasWeightedEdge(e.(WeightedEdge)), Equals, true, Commentf("edge %v", e))
+			n++
+			return
+		})
+		c.Assert(n, Equals, Size(ref))
+
+		ref.Vertices(func(v Vertex) (terminate bool) {
+			degree, _ := g.DegreeOf(v)
+			refdegree, _ := ref.DegreeOf(v)
+			c.Assert(degree, Equals, refdegree, Commentf("vertex %v", v))
+			return
+		})
+	}
+}
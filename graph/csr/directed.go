@@ -0,0 +1,225 @@
+package csr
+
+import (
+	. "github.com/sdboyer/gogl"
+)
+
+// A directed CSR graph. Its rows hold each vertex's out-arcs, by target; the in
+// rows hold each vertex's in-arcs, by source.
+type directed struct {
+	base
+	in rows
+}
+
+func newDirected(b base) directed {
+	return directed{base: b, in: b.adj.transpose()}
+}
+
+// Returns the arc from vertex i to vertex j, whose weight is at the provided position
+// in the provided rows.
+func (g *directed) arc(r *rows, i, j int32, pos int) Arc {
+	u, v := g.vertices[i], g.vertices[j]
+	if r.weights != nil {
+		return NewWeightedArc(u, v, r.weights[pos])
+	}
+	return NewArc(u, v)
+}
+
+// Returns the outdegree of the provided vertex. If the vertex is not present in the
+// graph, the second return value will be false.
+func (g *directed) OutDegreeOf(vertex Vertex) (degree int, exists bool) {
+	var i int32
+	if i, exists = g.ids[vertex]; exists {
+		degree = g.adj.degree(i)
+	}
+	return
+}
+
+// Returns the indegree of the provided vertex. If the vertex is not present in the
+// graph, the second return value will be false.
+func (g *directed) InDegreeOf(vertex Vertex) (degree int, exists bool) {
+	var i int32
+	if i, exists = g.ids[vertex]; exists {
+		degree = g.in.degree(i)
+	}
+	return
+}
+
+// Returns the degree of the given vertex, counting both in and out-edges.
+func (g *directed) DegreeOf(vertex Vertex) (degree int, exists bool) {
+	var i int32
+	if i, exists = g.ids[vertex]; exists {
+		degree = g.adj.degree(i) + g.in.degree(i)
+	}
+	return
+}
+
+// Traverses the set of edges in the graph, passing each edge to the
+// provided closure.
+func (g *directed) Edges(f EdgeStep) {
+	g.Arcs(func(a Arc) bool {
+		return f(a)
+	})
+}
+
+// Traverses the set of arcs in the graph, passing each arc to the
+// provided closure.
+func (g *directed) Arcs(f ArcStep) {
+	for i := range g.vertices {
+		start, end := g.adj.bounds(int32(i))
+		for pos := start; pos < end; pos++ {
+			if f(g.arc(&g.adj, int32(i), g.adj.targets[pos], pos)) {
+				return
+			}
+		}
+	}
+}
+
+// Enumerates the set of all edges incident to the provided vertex.
+func (g *directed) IncidentTo(v Vertex, f EdgeStep) {
+	var terminate bool
+	interloper := func(a Arc) bool {
+		terminate = terminate || f(a)
+		return terminate
+	}
+
+	g.ArcsFrom(v, interloper)
+	g.ArcsTo(v, interloper)
+}
+
+// Enumerates the vertices adjacent to the provided vertex.
+func (g *directed) AdjacentTo(vertex Vertex, f VertexStep) {
+	g.IncidentTo(vertex, func(e Edge) bool {
+		u, v := e.Both()
+		if u == vertex {
+			return f(v)
+		}
+		return f(u)
+	})
+}
+
+// Enumerates the set of out-edges for the provided vertex.
+func (g *directed) ArcsFrom(v Vertex, f ArcStep) {
+	if i, exists := g.ids[v]; exists {
+		start, end := g.adj.bounds(i)
+		for pos := start; pos < end; pos++ {
+			if f(g.arc(&g.adj, i, g.adj.targets[pos], pos)) {
+				return
+			}
+		}
+	}
+}
+
+// Enumerates the set of in-edges for the provided vertex.
+func (g *directed) ArcsTo(v Vertex, f ArcStep) {
+	if j, exists := g.ids[v]; exists {
+		start, end := g.in.bounds(j)
+		for pos := start; pos < end; pos++ {
+			if f(g.arc(&g.in, g.in.targets[pos], j, pos)) {
+				return
+			}
+		}
+	}
+}
+
+// Enumerates the vertices to which the provided vertex has an out-arc.
+func (g *directed) SuccessorsOf(v Vertex, f VertexStep) {
+	if i, exists := g.ids[v]; exists {
+		start, end := g.adj.bounds(i)
+		for _, j := range g.adj.targets[start:end] {
+			if f(g.vertices[j]) {
+				return
+			}
+		}
+	}
+}
+
+// Enumerates the vertices from which the provided vertex has an in-arc.
+func (g *directed) PredecessorsOf(v Vertex, f VertexStep) {
+	if j, exists := g.ids[v]; exists {
+		start, end := g.in.bounds(j)
+		for _, i := range g.in.targets[start:end] {
+			if f(g.vertices[i]) {
+				return
+			}
+		}
+	}
+}
+
+// Indicates whether or not the given edge is present in the graph, in either
+// direction. It matches based solely on the presence of an edge, disregarding
+// edge weight.
+func (g *directed) HasEdge(edge Edge) bool {
+	u, v := edge.Both()
+	_, exists := g.locate(&g.adj, u, v)
+	if !exists {
+		_, exists = g.locate(&g.adj, v, u)
+	}
+	return exists
+}
+
+// Indicates whether or not the given arc is present in the graph.
+func (g *directed) HasArc(arc Arc) bool {
+	_, exists := g.locate(&g.adj, arc.Source(), arc.Target())
+	return exists
+}
+
+// Returns the density of the graph. Density is the ratio of edge count to the
+// number of edges there would be in complete graph (maximum edge count).
+func (g *directed) Density() float64 {
+	order := g.Order()
+	return float64(g.Size()) / float64(order*(order-1))
+}
+
+// Returns the core of the transposed graph, which simply swaps the two sets of rows.
+func (g *directed) transpose() directed {
+	t := *g
+	t.adj, t.in = g.in, g.adj
+	return t
+}
+
+type basicDirected struct {
+	directed
+}
+
+// Returns a graph with the same vertex and edge set, but with the
+// directionality of all its edges reversed.
+//
+// The transpose shares all of its memory with the original, and is
+// produced in constant time.
+func (g *basicDirected) Transpose() Digraph {
+	return &basicDirected{g.transpose()}
+}
+
+type weightedDirected struct {
+	directed
+}
+
+// Indicates whether or not the given weighted edge is present in the graph,
+// in either direction. It will only match if the provided WeightedEdge has the
+// same weight as the edge contained in the graph.
+func (g *weightedDirected) HasWeightedEdge(edge WeightedEdge) bool {
+	u, v := edge.Both()
+	if pos, exists := g.locate(&g.adj, u, v); exists && g.adj.weights[pos] == edge.Weight() {
+		return true
+	}
+	pos, exists := g.locate(&g.adj, v, u)
+	return exists && g.adj.weights[pos] == edge.Weight()
+}
+
+// Indicates whether or not the given weighted arc is present in the graph.
+// It will only match if the provided WeightedArc has the same weight as
+// the arc contained in the graph.
+func (g *weightedDirected) HasWeightedArc(arc WeightedArc) bool {
+	pos, exists := g.locate(&g.adj, arc.Source(), arc.Target())
+	return exists && g.adj.weights[pos] == arc.Weight()
+}
+
+// Returns a graph with the same vertex and edge set, but with the
+// directionality of all its edges reversed.
+//
+// The transpose shares all of its memory with the original, and is
+// produced in constant time.
+func (g *weightedDirected) Transpose() Digraph {
+	return &weightedDirected{g.transpose()}
+}
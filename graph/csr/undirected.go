@@ -0,0 +1,100 @@
+package csr
+
+import (
+	"sort"
+
+	. "github.com/sdboyer/gogl"
+)
+
+type undirected struct {
+	base
+}
+
+// Returns the edge at the provided position in the row for vertex i.
+func (g *undirected) edge(i int32, pos int) Edge {
+	u, v := g.vertices[i], g.vertices[g.adj.targets[pos]]
+	if g.adj.weights != nil {
+		return NewWeightedEdge(u, v, g.adj.weights[pos])
+	}
+	return NewEdge(u, v)
+}
+
+// Returns the degree of the provided vertex. If the vertex is not present in the
+// graph, the second return value will be false.
+func (g *undirected) DegreeOf(vertex Vertex) (degree int, exists bool) {
+	var i int32
+	if i, exists = g.ids[vertex]; exists {
+		degree = g.adj.degree(i)
+	}
+	return
+}
+
+// Traverses the set of edges in the graph, passing each edge to the
+// provided closure.
+func (g *undirected) Edges(f EdgeStep) {
+	for i := range g.vertices {
+		start, end := g.adj.bounds(int32(i))
+		// Runs are sorted, so skip to the entries at or above the diagonal; each
+		// edge then appears exactly once.
+		pos := start + sort.Search(end-start, func(k int) bool {
+			return g.adj.targets[start+k] >= int32(i)
+		})
+		for ; pos < end; pos++ {
+			if f(g.edge(int32(i), pos)) {
+				return
+			}
+		}
+	}
+}
+
+// Enumerates the set of all edges incident to the provided vertex.
+func (g *undirected) IncidentTo(v Vertex, f EdgeStep) {
+	if i, exists := g.ids[v]; exists {
+		start, end := g.adj.bounds(i)
+		for pos := start; pos < end; pos++ {
+			if f(g.edge(i, pos)) {
+				return
+			}
+		}
+	}
+}
+
+// Enumerates the vertices adjacent to the provided vertex.
+func (g *undirected) AdjacentTo(vertex Vertex, f VertexStep) {
+	if i, exists := g.ids[vertex]; exists {
+		start, end := g.adj.bounds(i)
+		for _, j := range g.adj.targets[start:end] {
+			if f(g.vertices[j]) {
+				return
+			}
+		}
+	}
+}
+
+// Indicates whether or not the given edge is present in the graph. It matches
+// based solely on the presence of an edge, disregarding edge weight.
+func (g *undirected) HasEdge(edge Edge) bool {
+	u, v := edge.Both()
+	_, exists := g.locate(&g.adj, u, v)
+	return exists
+}
+
+// Returns the density of the graph. Density is the ratio of edge count to the
+// number of edges there would be in complete graph (maximum edge count).
+func (g *undirected) Density() float64 {
+	order := g.Order()
+	return 2 * float64(g.Size()) / float64(order*(order-1))
+}
+
+type weightedUndirected struct {
+	undirected
+}
+
+// Indicates whether or not the given weighted edge is present in the graph.
+// It will only match if the provided WeightedEdge has the same weight as
+// the edge contained in the graph.
+func (g *weightedUndirected) HasWeightedEdge(edge WeightedEdge) bool {
+	u, v := edge.Both()
+	pos, exists := g.locate(&g.adj, u, v)
+	return exists && g.adj.weights[pos] == edge.Weight()
+}
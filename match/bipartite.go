@@ -0,0 +1,118 @@
+// Contains algos and logic related to bipartite graphs and matchings.
+//
+// All algorithms here treat the graph as undirected; for a digraph, arc
+// directionality is ignored.
+package match
+
+import (
+	"fmt"
+
+	"github.com/sdboyer/gogl"
+)
+
+// A NotBipartiteError is returned by matching algorithms when provided with a graph
+// that is not bipartite. It contains an odd cycle from the graph, as found by IsBipartite.
+type NotBipartiteError struct {
+	Cycle []gogl.Vertex
+}
+
+func (e NotBipartiteError) Error() string {
+	return fmt.Sprintf("Graph is not bipartite; it contains an odd cycle: %v", e.Cycle)
+}
+
+// Determines whether or not the provided graph is bipartite: whether its vertices can
+// be divided into two sets, such that every edge connects a vertex in one set to a
+// vertex in the other.
+//
+// If the graph is bipartite, a two-coloring is returned: every vertex is mapped to
+// either 0 or 1, and no edge connects two vertices of the same color. Within each
+// connected component, which set is colored 0 is arbitrary.
+//
+// If the graph is not bipartite, an odd cycle is returned instead, as a witness. It is
+// in traversal order: each vertex is adjacent to the next, and the last vertex is
+// adjacent to the first. The first vertex is not repeated at the end.
+func IsBipartite(g gogl.Graph) (bipartite bool, coloring map[gogl.Vertex]int, cycle []gogl.Vertex) {
+	coloring = make(map[gogl.Vertex]int)
+	parent := make(map[gogl.Vertex]gogl.Vertex)
+	depth := make(map[gogl.Vertex]int)
+
+	g.Vertices(func(root gogl.Vertex) (terminate bool) {
+		if _, colored := coloring[root]; colored {
+			return
+		}
+
+		coloring[root] = 0
+		queue := []gogl.Vertex{root}
+		for len(queue) > 0 && cycle == nil {
+			v := queue[0]
+			queue = queue[1:]
+
+			g.AdjacentTo(v, func(adj gogl.Vertex) (terminate bool) {
+				c, colored := coloring[adj]
+				if !colored {
+					coloring[adj] = 1 - coloring[v]
+					parent[adj] = v
+					depth[adj] = depth[v] + 1
+					queue = append(queue, adj)
+				} else if c == coloring[v] {
+					cycle = oddCycle(v, adj, parent, depth)
+					return true
+				}
+				return
+			})
+		}
+
+		return cycle != nil
+	})
+
+	if cycle != nil {
+		return false, nil, cycle
+	}
+
+	return true, coloring, nil
+}
+
+// Builds the odd cycle formed by an edge between two same-colored vertices, u and w,
+// and their paths up the breadth-first tree to their lowest common ancestor.
+func oddCycle(u, w gogl.Vertex, parent map[gogl.Vertex]gogl.Vertex, depth map[gogl.Vertex]int) []gogl.Vertex {
+	var up, down []gogl.Vertex
+	for depth[u] > depth[w] {
+		up = append(up, u)
+		u = parent[u]
+	}
+	for depth[w] > depth[u] {
+		down = append(down, w)
+		w = parent[w]
+	}
+	for u != w {
+		up = append(up, u)
+		down = append(down, w)
+		u, w = parent[u], parent[w]
+	}
+
+	// up runs from the original u to just below the ancestor; follow it with the
+	// ancestor, then walk back down to the original w.
+	cycle := append(up, u)
+	for i := len(down) - 1; i >= 0; i-- {
+		cycle = append(cycle, down[i])
+	}
+
+	return cycle
+}
+
+// Splits the vertices of a bipartite graph into its two color classes, assigning each
+// a dense index.
+func partition(coloring map[gogl.Vertex]int) (left, right []gogl.Vertex, index map[gogl.Vertex]int) {
+	index = make(map[gogl.Vertex]int, len(coloring))
+	for v, c := range coloring {
+		if c == 0 {
+			index[v] = len(left)
+			left = append(left, v)
+		} else {
+			index[v] = len(right)
+			right = append(right, v)
+		}
+	}
+
+	return
+}
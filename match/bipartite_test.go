@@ -0,0 +1,90 @@
+package match
+
+import (
+	"testing"
+
+	. "github.com/sdboyer/gocheck"
+	"github.com/sdboyer/gogl"
+	"github.com/sdboyer/gogl/graph/al"
+)
+
+// Hook gocheck into the go test runner
+func Test(t *testing.T) { TestingT(t) }
+
+// Jobs on the left, workers on the right; plus a separate component, a 4-cycle.
+var jobEdgeSet = gogl.EdgeList{
+	gogl.NewEdge("build", "alice"),
+	gogl.NewEdge("build", "bob"),
+	gogl.NewEdge("test", "alice"),
+	gogl.NewEdge("deploy", "bob"),
+	gogl.NewEdge("deploy", "carol"),
+	gogl.NewEdge("docs", "carol"),
+	gogl.NewEdge("p", "q"),
+	gogl.NewEdge("q", "r"),
+	gogl.NewEdge("r", "s"),
+	gogl.NewEdge("s", "p"),
+}
+
+type BipartiteSuite struct{}
+
+var _ = Suite(&BipartiteSuite{})
+
+func (s *BipartiteSuite) TestIsBipartite(c *C) {
+	g := gogl.Spec().Using(jobEdgeSet).Create(al.G)
+
+	bipartite, coloring, cycle := IsBipartite(g)
+	c.Assert(bipartite, Equals, true)
+	c.Assert(cycle, IsNil)
+	c.Assert(len(coloring), Equals, gogl.Order(g))
+
+	g.Edges(func(e gogl.Edge) (terminate bool) {
+		u, v := e.Both()
+		c.Assert(coloring[u], Not(Equals), coloring[v], Commentf("edge %v", e))
+		return
+	})
+	c.Assert(coloring["build"], Equals, coloring["docs"])
+	c.Assert(coloring["p"], Equals, coloring["r"])
+
+	// Directionality is ignored.
+	dg := gogl.Spec().Directed().Using(gogl.ArcList{
+		gogl.NewArc("a", "b"),
+		gogl.NewArc("c", "b"),
+	}).Create(al.G)
+	bipartite, coloring, _ = IsBipartite(dg)
+	c.Assert(bipartite, Equals, true)
+	c.Assert(coloring["a"], Equals, coloring["c"])
+}
+
+func (s *BipartiteSuite) TestIsBipartiteOddCycle(c *C) {
+	el := append(gogl.EdgeList{
+		gogl.NewEdge("x", "y"),
+		gogl.NewEdge("y", "z"),
+		gogl.NewEdge("z", "v"),
+		gogl.NewEdge("v", "w"),
+		gogl.NewEdge("w", "x"),
+	}, jobEdgeSet...)
+	g := gogl.Spec().Using(el).Create(al.G)
+
+	bipartite, coloring, cycle := IsBipartite(g)
+	c.Assert(bipartite, Equals, false)
+	c.Assert(coloring, IsNil)
+	c.Assert(len(cycle), Equals, 5)
+
+	seen := make(map[gogl.Vertex]bool)
+	for i, v := range cycle {
+		c.Assert(seen[v], Equals, false)
+		seen[v] = true
+		c.Assert(g.HasEdge(gogl.NewEdge(v, cycle[(i+1)%len(cycle)])), Equals, true)
+	}
+
+	_, err := HopcroftKarp(g)
+	c.Assert(err, ErrorMatches, "Graph is not bipartite; it contains an odd cycle: .*")
+	c.Assert(err.(NotBipartiteError).Cycle, HasLen, 5)
+}
+
+func (s *BipartiteSuite) TestIsBipartiteEmpty(c *C) {
+	bipartite, coloring, cycle := IsBipartite(gogl.Spec().Create(al.G))
+	c.Assert(bipartite, Equals, true)
+	c.Assert(coloring, HasLen, 0)
+	c.Assert(cycle, IsNil)
+}
@@ -0,0 +1,120 @@
+package match
+
+import (
+	"github.com/sdboyer/gogl"
+)
+
+// The layer of a left vertex not reached by the current phase's search.
+const unlayered = -1
+
+// Finds a maximum cardinality matching in the provided bipartite graph using the
+// Hopcroft-Karp algorithm.
+//
+// A matching is a set of edges, no two of which share a vertex. The edges of the
+// matching are returned; their orientation is arbitrary. If the graph is not bipartite,
+// a NotBipartiteError is returned instead.
+//
+// Hopcroft-Karp augments along a maximal set of shortest vertex-disjoint augmenting
+// paths in each phase, and runs in O(E sqrt(V)) time.
+func HopcroftKarp(g gogl.Graph) (gogl.EdgeList, error) {
+	bipartite, coloring, cycle := IsBipartite(g)
+	if !bipartite {
+		return nil, NotBipartiteError{Cycle: cycle}
+	}
+
+	left, right, index := partition(coloring)
+	hk := &hopcroftKarp{
+		adj:   make([][]int, len(left)),
+		mateL: make([]int, len(left)),
+		mateR: make([]int, len(right)),
+		layer: make([]int, len(left)),
+	}
+
+	for i, v := range left {
+		g.AdjacentTo(v, func(adj gogl.Vertex) (terminate bool) {
+			hk.adj[i] = append(hk.adj[i], index[adj])
+			return
+		})
+	}
+
+	for i := range hk.mateL {
+		hk.mateL[i] = -1
+	}
+	for j := range hk.mateR {
+		hk.mateR[j] = -1
+	}
+
+	for hk.bfs() {
+		for i := range left {
+			if hk.mateL[i] == -1 {
+				hk.dfs(i)
+			}
+		}
+	}
+
+	var matching gogl.EdgeList
+	for i, j := range hk.mateL {
+		if j != -1 {
+			matching = append(matching, gogl.NewEdge(left[i], right[j]))
+		}
+	}
+
+	return matching, nil
+}
+
+// State for a Hopcroft-Karp run. Left vertices are indexed into adj, mateL and layer;
+// right vertices into mateR. A mate of -1 indicates an unmatched vertex.
+type hopcroftKarp struct {
+	adj          [][]int
+	mateL, mateR []int
+	layer        []int
+}
+
+// Layers the left vertices by their alternating path distance from the unmatched
+// left vertices. Reports whether any augmenting path exists.
+func (hk *hopcroftKarp) bfs() bool {
+	var queue []int
+	for i, j := range hk.mateL {
+		if j == -1 {
+			hk.layer[i] = 0
+			queue = append(queue, i)
+		} else {
+			hk.layer[i] = unlayered
+		}
+	}
+
+	found := false
+	for len(queue) > 0 {
+		i := queue[0]
+		queue = queue[1:]
+
+		for _, j := range hk.adj[i] {
+			next := hk.mateR[j]
+			if next == -1 {
+				found = true
+			} else if hk.layer[next] == unlayered {
+				hk.layer[next] = hk.layer[i] + 1
+				queue = append(queue, next)
+			}
+		}
+	}
+
+	return found
+}
+
+// Searches for an augmenting path from left vertex i along the layering, flipping the
+// matching along it if one is found.
+func (hk *hopcroftKarp) dfs(i int) bool {
+	for _, j := range hk.adj[i] {
+		next := hk.mateR[j]
+		if next == -1 || (hk.layer[next] == hk.layer[i]+1 && hk.dfs(next)) {
+			hk.mateL[i] = j
+			hk.mateR[j] = i
+			return true
+		}
+	}
+
+	// Dead end; exclude this vertex from the rest of the phase.
+	hk.layer[i] = unlayered
+	return false
+}
@@ -0,0 +1,77 @@
+package match
+
+import (
+	stdrand "math/rand"
+
+	. "github.com/sdboyer/gocheck"
+	"github.com/sdboyer/gogl"
+	"github.com/sdboyer/gogl/graph/al"
+)
+
+type HopcroftKarpSuite struct{}
+
+var _ = Suite(&HopcroftKarpSuite{})
+
+// Verifies that no two edges in the matching share a vertex, and that each is in g.
+func checkMatching(c *C, g gogl.Graph, matching gogl.EdgeList) {
+	used := make(map[gogl.Vertex]bool)
+	for _, e := range matching {
+		u, v := e.Both()
+		c.Assert(g.HasEdge(e), Equals, true, Commentf("edge %v", e))
+		c.Assert(used[u] || used[v], Equals, false, Commentf("edge %v", e))
+		used[u], used[v] = true, true
+	}
+}
+
+// Calculates the maximum matching size by exhaustive search over the left vertices.
+func bruteMatching(left []int, adj map[int][]int, used map[int]bool) int {
+	if len(left) == 0 {
+		return 0
+	}
+
+	best := bruteMatching(left[1:], adj, used)
+	for _, r := range adj[left[0]] {
+		if !used[r] {
+			used[r] = true
+			if size := 1 + bruteMatching(left[1:], adj, used); size > best {
+				best = size
+			}
+			used[r] = false
+		}
+	}
+	return best
+}
+
+func (s *HopcroftKarpSuite) TestHopcroftKarp(c *C) {
+	g := gogl.Spec().Using(jobEdgeSet).Create(al.G)
+
+	matching, err := HopcroftKarp(g)
+	c.Assert(err, IsNil)
+	c.Assert(matching, HasLen, 5)
+	checkMatching(c, g, matching)
+}
+
+func (s *HopcroftKarpSuite) TestHopcroftKarpRandom(c *C) {
+	r := stdrand.New(stdrand.NewSource(7))
+	for iter := 0; iter < 50; iter++ {
+		// left vertices are 0-7, right vertices are 100-107
+		g := gogl.Spec().Create(al.G).(gogl.MutableGraph)
+		adj := make(map[int][]int)
+		var left []int
+		for i := 0; i < 8; i++ {
+			left = append(left, i)
+			g.EnsureVertex(i, 100+i)
+			for j := 0; j < 8; j++ {
+				if r.Float64() < 0.25 {
+					g.AddEdges(gogl.NewEdge(i, 100+j))
+					adj[i] = append(adj[i], 100+j)
+				}
+			}
+		}
+
+		matching, err := HopcroftKarp(g)
+		c.Assert(err, IsNil)
+		checkMatching(c, g, matching)
+		c.Assert(len(matching), Equals, bruteMatching(left, adj, make(map[int]bool)), Commentf("iteration %d", iter))
+	}
+}
@@ -0,0 +1,156 @@
+package match
+
+import (
+	"errors"
+	"math"
+
+	"github.com/sdboyer/gogl"
+)
+
+// Solves the assignment problem over the provided weighted bipartite graph using the
+// Hungarian algorithm, treating edge weights as costs.
+//
+// The returned matching has maximum cardinality - as many vertices are paired as the
+// graph's edges allow - and, among all such matchings, the minimum total weight. Its
+// edges are returned along with that total weight. Negative weights are permitted. If
+// there are parallel edges between two vertices, only the cheapest is considered.
+//
+// If the graph is not bipartite, a NotBipartiteError is returned. If any edge in the
+// graph is not weighted, an error is returned.
+//
+// The Hungarian algorithm runs in O(n^2 m) time, where n and m are the sizes of the
+// smaller and larger sides of the graph.
+func Hungarian(g gogl.WeightedGraph) (matching gogl.WeightedEdgeList, weight float64, err error) {
+	bipartite, coloring, cycle := IsBipartite(g)
+	if !bipartite {
+		return nil, 0, NotBipartiteError{Cycle: cycle}
+	}
+
+	rows, cols, index := partition(coloring)
+	transposed := len(rows) > len(cols)
+	if transposed {
+		rows, cols = cols, rows
+	}
+
+	n, m := len(rows), len(cols)
+	cost := make([][]float64, n)
+	present := make([][]bool, n)
+	for i := range cost {
+		cost[i] = make([]float64, m)
+		present[i] = make([]bool, m)
+	}
+
+	var total float64
+	g.Edges(func(e gogl.Edge) (terminate bool) {
+		we, ok := e.(gogl.WeightedEdge)
+		if !ok {
+			err = errors.New("Graph contains an edge that is not weighted.")
+			return true
+		}
+
+		u, v := e.Both()
+		if (coloring[u] == 1) != transposed {
+			u, v = v, u
+		}
+		i, j, w := index[u], index[v], we.Weight()
+		if !present[i][j] || w < cost[i][j] {
+			cost[i][j] = w
+			present[i][j] = true
+		}
+		total += math.Abs(w)
+		return
+	})
+
+	if err != nil {
+		return nil, 0, err
+	}
+
+	// Absent pairs cost more than any difference in total weight between two
+	// assignments, so a solution never trades a real pairing for a cheaper total.
+	absent := 2*total + 1
+	for i := range cost {
+		for j := range cost[i] {
+			if !present[i][j] {
+				cost[i][j] = absent
+			}
+		}
+	}
+
+	for j, i := range hungarian(cost, m) {
+		if i != -1 && present[i][j] {
+			matching = append(matching, gogl.NewWeightedEdge(rows[i], cols[j], cost[i][j]))
+			weight += cost[i][j]
+		}
+	}
+
+	return matching, weight, nil
+}
+
+// Solves a rectangular assignment problem with n rows and m >= n columns, returning
+// the row assigned to each column, or -1 for unassigned columns.
+//
+// This is the O(n^2 m) shortest augmenting path formulation, which maintains dual
+// potentials for rows (u) and columns (v). Internally, rows and columns are indexed
+// from 1; column 0 is a sentinel from which each new row's augmenting path begins.
+func hungarian(cost [][]float64, m int) []int {
+	n := len(cost)
+	inf := math.Inf(1)
+
+	u := make([]float64, n+1)
+	v := make([]float64, m+1)
+	p := make([]int, m+1)
+	way := make([]int, m+1)
+
+	for i := 1; i <= n; i++ {
+		p[0] = i
+		j0 := 0
+		minv := make([]float64, m+1)
+		used := make([]bool, m+1)
+		for j := range minv {
+			minv[j] = inf
+		}
+
+		for p[j0] != 0 {
+			used[j0] = true
+			i0, delta, j1 := p[j0], inf, 0
+
+			for j := 1; j <= m; j++ {
+				if used[j] {
+					continue
+				}
+				if cur := cost[i0-1][j-1] - u[i0] - v[j]; cur < minv[j] {
+					minv[j] = cur
+					way[j] = j0
+				}
+				if minv[j] < delta {
+					delta = minv[j]
+					j1 = j
+				}
+			}
+
+			for j := 0; j <= m; j++ {
+				if used[j] {
+					u[p[j]] += delta
+					v[j] -= delta
+				} else {
+					minv[j] -= delta
+				}
+			}
+			j0 = j1
+		}
+
+		// Flip the assignment back along the augmenting path.
+		for j0 != 0 {
+			j1 := way[j0]
+			p[j0] = p[j1]
+			j0 = j1
+		}
+	}
+
+	assignment := make([]int, m)
+	for j := 1; j <= m; j++ {
+		assignment[j-1] = p[j] - 1
+	}
+
+	return assignment
+}
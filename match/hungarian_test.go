@@ -0,0 +1,129 @@
+package match
+
+import (
+	"math"
+	stdrand "math/rand"
+
+	. "github.com/sdboyer/gocheck"
+	"github.com/sdboyer/gogl"
+	"github.com/sdboyer/gogl/graph/al"
+)
+
+// Costs for three jobs across four workers; the optimal assignment costs 2+3+4.
+var assignmentEdgeSet = gogl.WeightedEdgeList{
+	gogl.NewWeightedEdge("build", "alice", 2),
+	gogl.NewWeightedEdge("build", "bob", 5),
+	gogl.NewWeightedEdge("build", "carol", 9),
+	gogl.NewWeightedEdge("test", "alice", 4),
+	gogl.NewWeightedEdge("test", "bob", 3),
+	gogl.NewWeightedEdge("test", "dave", 8),
+	gogl.NewWeightedEdge("deploy", "alice", 1),
+	gogl.NewWeightedEdge("deploy", "carol", 4),
+	gogl.NewWeightedEdge("deploy", "dave", 7),
+}
+
+type HungarianSuite struct{}
+
+var _ = Suite(&HungarianSuite{})
+
+// Returns the largest matching size and the minimum weight among matchings of that size,
+// by exhaustive search over the left vertices.
+func bruteAssignment(left []int, cost map[[2]int]float64, right []int, used map[int]bool) (int, float64) {
+	if len(left) == 0 {
+		return 0, 0
+	}
+
+	bestSize, bestWeight := bruteAssignment(left[1:], cost, right, used)
+	for _, r := range right {
+		w, exists := cost[[2]int{left[0], r}]
+		if !exists || used[r] {
+			continue
+		}
+
+		used[r] = true
+		size, weight := bruteAssignment(left[1:], cost, right, used)
+		size, weight = size+1, weight+w
+		if size > bestSize || (size == bestSize && weight < bestWeight) {
+			bestSize, bestWeight = size, weight
+		}
+		used[r] = false
+	}
+	return bestSize, bestWeight
+}
+
+func (s *HungarianSuite) TestHungarian(c *C) {
+	g := gogl.Spec().Weighted().Using(assignmentEdgeSet).Create(al.G).(gogl.WeightedGraph)
+
+	matching, weight, err := Hungarian(g)
+	c.Assert(err, IsNil)
+	c.Assert(weight, Equals, float64(9))
+	c.Assert(matching, HasLen, 3)
+
+	var el gogl.EdgeList
+	for _, e := range matching {
+		c.Assert(g.HasWeightedEdge(e), Equals, true, Commentf("edge %v", e))
+		el = append(el, e)
+	}
+	checkMatching(c, g, el)
+}
+
+func (s *HungarianSuite) TestHungarianRandom(c *C) {
+	r := stdrand.New(stdrand.NewSource(11))
+	for iter := 0; iter < 50; iter++ {
+		// left vertices are 0-(nl-1), right vertices are 100-(100+nr-1); sides vary
+		// in size so that both orientations of the cost matrix are exercised.
+		nl, nr := 2+r.Intn(5), 2+r.Intn(5)
+		g := gogl.Spec().Weighted().Mutable().Create(al.G).(gogl.MutableWeightedGraph)
+		cost := make(map[[2]int]float64)
+		var left, right []int
+
+		for j := 0; j < nr; j++ {
+			right = append(right, 100+j)
+			g.EnsureVertex(100 + j)
+		}
+		for i := 0; i < nl; i++ {
+			left = append(left, i)
+			g.EnsureVertex(i)
+			for _, j := range right {
+				if r.Float64() < 0.6 {
+					w := float64(r.Intn(21) - 5)
+					g.AddEdges(gogl.NewWeightedEdge(i, j, w))
+					cost[[2]int{i, j}] = w
+				}
+			}
+		}
+
+		matching, weight, err := Hungarian(g)
+		c.Assert(err, IsNil)
+
+		size, expected := bruteAssignment(left, cost, right, make(map[int]bool))
+		c.Assert(matching, HasLen, size, Commentf("iteration %d", iter))
+		c.Assert(math.Abs(weight-expected) < 1e-9, Equals, true, Commentf("iteration %d: %v != %v", iter, weight, expected))
+	}
+}
+
+// A weighted graph wrapper that yields unweighted edges.
+type unweighted struct {
+	gogl.WeightedGraph
+}
+
+func (g unweighted) Edges(f gogl.EdgeStep) {
+	g.WeightedGraph.Edges(func(e gogl.Edge) (terminate bool) {
+		return f(gogl.NewEdge(e.Both()))
+	})
+}
+
+func (s *HungarianSuite) TestHungarianErrors(c *C) {
+	g := gogl.Spec().Weighted().Using(assignmentEdgeSet).Create(al.G).(gogl.WeightedGraph)
+	_, _, err := Hungarian(unweighted{g})
+	c.Assert(err, ErrorMatches, "Graph contains an edge that is not weighted.")
+
+	triangle := gogl.Spec().Weighted().Using(gogl.WeightedEdgeList{
+		gogl.NewWeightedEdge("a", "b", 1),
+		gogl.NewWeightedEdge("b", "c", 1),
+		gogl.NewWeightedEdge("c", "a", 1),
+	}).Create(al.G).(gogl.WeightedGraph)
+	_, _, err = Hungarian(triangle)
+	_, ok := err.(NotBipartiteError)
+	c.Assert(ok, Equals, true)
+}
@@ -0,0 +1,55 @@
+package mst
+
+import (
+	"errors"
+	"sort"
+
+	"github.com/sdboyer/gogl"
+	"github.com/sdboyer/gogl/components"
+)
+
+// Calculates a minimum spanning forest of the provided graph using Kruskal's algorithm.
+//
+// The edges of the forest are returned, along with their total weight. If any edge in
+// the graph is not weighted, an error is returned instead.
+//
+// Kruskal's algorithm sorts all edges up front, then adds them in order of increasing
+// weight, skipping those that would form a cycle. It runs in O(E log E) time, and is
+// generally the better choice for sparse graphs.
+func Kruskal(g gogl.WeightedGraph) (forest gogl.WeightedEdgeList, weight float64, err error) {
+	var edges byWeight
+	g.Edges(func(e gogl.Edge) (terminate bool) {
+		we, ok := e.(gogl.WeightedEdge)
+		if !ok {
+			err = errors.New("Graph contains an edge that is not weighted.")
+			return true
+		}
+		edges = append(edges, we)
+		return
+	})
+
+	if err != nil {
+		return nil, 0, err
+	}
+
+	sort.Sort(edges)
+
+	ds := components.NewDisjointSet()
+	forest = make(gogl.WeightedEdgeList, 0, gogl.Order(g))
+	for _, e := range edges {
+		if ds.Union(e.Both()) {
+			forest = append(forest, e)
+		}
+	}
+
+	return forest, totalWeight(forest), nil
+}
+
+// Sorts a set of weighted edges in order of increasing weight. Implements sort.Interface.
+type byWeight []gogl.WeightedEdge
+
+func (s byWeight) Len() int { return len(s) }
+
+func (s byWeight) Less(i, j int) bool { return s[i].Weight() < s[j].Weight() }
+
+func (s byWeight) Swap(i, j int) { s[i], s[j] = s[j], s[i] }
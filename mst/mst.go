@@ -0,0 +1,42 @@
+// Contains algos and logic related to minimum spanning trees and forests.
+//
+// All algorithms here treat the graph as undirected; for a digraph, arc
+// directionality is ignored. Edge weights are read by type asserting to
+// WeightedEdge.
+//
+// If the graph is not connected, a minimum spanning forest - one minimum
+// spanning tree per connected component - is produced instead.
+package mst
+
+import (
+	"github.com/sdboyer/gogl"
+)
+
+// Creates a new graph from a spanning forest, using the provided GraphSpec and creator
+// function. The spec is forced to be weighted and undirected, and its source is replaced.
+//
+// The vertices of the new graph are taken from the provided vertex enumerator, which
+// should typically be the graph that the forest spans. This ensures that vertex isolates,
+// which have no edge in the forest, are retained.
+func Materialize(vertices gogl.VertexEnumerator, forest gogl.WeightedEdgeList, gs gogl.GraphSpec, f func(gogl.GraphSpec) gogl.Graph) gogl.WeightedGraph {
+	src := forestSource{vertices, forest}
+	return gs.Undirected().Weighted().Using(src).Create(f).(gogl.WeightedGraph)
+}
+
+// A GraphSource combining a full vertex set with the edges of a spanning forest.
+type forestSource struct {
+	gogl.VertexEnumerator
+	forest gogl.WeightedEdgeList
+}
+
+func (s forestSource) Edges(f gogl.EdgeStep) {
+	s.forest.Edges(f)
+}
+
+// Sums the weights of all the edges in a forest.
+func totalWeight(forest gogl.WeightedEdgeList) (total float64) {
+	for _, e := range forest {
+		total += e.Weight()
+	}
+	return
+}
@@ -0,0 +1,115 @@
+package mst
+
+import (
+	"testing"
+
+	. "github.com/sdboyer/gocheck"
+	"github.com/sdboyer/gogl"
+	"github.com/sdboyer/gogl/components"
+	"github.com/sdboyer/gogl/graph/al"
+)
+
+// Hook gocheck into the go test runner
+func Test(t *testing.T) { TestingT(t) }
+
+// Two components: a weighted seven-vertex graph with an MST weight of 39, and a
+// triangle with an MST weight of 3.
+var mstEdgeSet = gogl.WeightedEdgeList{
+	gogl.NewWeightedEdge("a", "b", 7),
+	gogl.NewWeightedEdge("a", "d", 5),
+	gogl.NewWeightedEdge("b", "c", 8),
+	gogl.NewWeightedEdge("b", "d", 9),
+	gogl.NewWeightedEdge("b", "e", 7),
+	gogl.NewWeightedEdge("c", "e", 5),
+	gogl.NewWeightedEdge("d", "e", 15),
+	gogl.NewWeightedEdge("d", "f", 6),
+	gogl.NewWeightedEdge("e", "f", 8),
+	gogl.NewWeightedEdge("e", "g", 9),
+	gogl.NewWeightedEdge("f", "g", 11),
+	gogl.NewWeightedEdge("x", "y", 1),
+	gogl.NewWeightedEdge("y", "z", 2),
+	gogl.NewWeightedEdge("z", "x", 3),
+}
+
+type MSTSuite struct {
+	algos map[string]func(gogl.WeightedGraph) (gogl.WeightedEdgeList, float64, error)
+}
+
+var _ = Suite(&MSTSuite{
+	algos: map[string]func(gogl.WeightedGraph) (gogl.WeightedEdgeList, float64, error){
+		"Kruskal": Kruskal,
+		"Prim":    Prim,
+	},
+})
+
+func (s *MSTSuite) TestForest(c *C) {
+	g := gogl.Spec().Mutable().Weighted().Using(mstEdgeSet).Create(al.G).(gogl.MutableWeightedGraph)
+	g.EnsureVertex("isolate")
+
+	for name, algo := range s.algos {
+		forest, weight, err := algo(g)
+		c.Assert(err, IsNil)
+		c.Assert(weight, Equals, float64(42), Commentf(name))
+		// V - C edges: 11 vertices, 3 components
+		c.Assert(len(forest), Equals, 8, Commentf(name))
+
+		for _, e := range forest {
+			c.Assert(g.HasWeightedEdge(e), Equals, true)
+		}
+
+		c.Assert(forest, Not(Contains), gogl.NewWeightedEdge("d", "e", 15))
+
+		// the forest must connect exactly what the graph connects
+		c.Assert(len(components.ConnectedComponentsOf(forest)), Equals, 2)
+	}
+}
+
+func (s *MSTSuite) TestDirected(c *C) {
+	// direction is ignored
+	g := gogl.Spec().Directed().Weighted().Using(gogl.WeightedArcList{
+		gogl.NewWeightedArc(1, 2, 4),
+		gogl.NewWeightedArc(3, 2, 1),
+		gogl.NewWeightedArc(1, 3, 2),
+	}).Create(al.G).(gogl.WeightedGraph)
+
+	for name, algo := range s.algos {
+		forest, weight, err := algo(g)
+		c.Assert(err, IsNil)
+		c.Assert(weight, Equals, float64(3), Commentf(name))
+		c.Assert(len(forest), Equals, 2)
+	}
+}
+
+func (s *MSTSuite) TestUnweighted(c *C) {
+	// al can't produce unweighted edges from a weighted graph, so fake one
+	g := unweighted{gogl.Spec().Using(gogl.EdgeList{gogl.NewEdge(1, 2)}).Create(al.G)}
+
+	for _, algo := range s.algos {
+		_, _, err := algo(g)
+		c.Assert(err, ErrorMatches, ".*not weighted.*")
+	}
+}
+
+type unweighted struct {
+	gogl.Graph
+}
+
+func (g unweighted) HasWeightedEdge(e gogl.WeightedEdge) bool {
+	return false
+}
+
+func (s *MSTSuite) TestMaterialize(c *C) {
+	g := gogl.Spec().Mutable().Weighted().Using(mstEdgeSet).Create(al.G).(gogl.MutableWeightedGraph)
+	g.EnsureVertex("isolate")
+
+	forest, _, _ := Kruskal(g)
+	t := Materialize(g, forest, gogl.Spec().Mutable(), al.G)
+
+	c.Assert(gogl.Order(t), Equals, 11)
+	c.Assert(gogl.Size(t), Equals, 8)
+	c.Assert(t.HasVertex("isolate"), Equals, true)
+	c.Assert(t.HasWeightedEdge(forest[0]), Equals, true)
+
+	_, isDigraph := t.(gogl.Digraph)
+	c.Assert(isDigraph, Equals, false)
+}
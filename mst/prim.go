@@ -0,0 +1,98 @@
+package mst
+
+import (
+	"container/heap"
+	"errors"
+
+	"github.com/sdboyer/gogl"
+)
+
+// Calculates a minimum spanning forest of the provided graph using Prim's algorithm.
+//
+// The edges of the forest are returned, along with their total weight. If any edge in
+// the graph is not weighted, an error is returned instead.
+//
+// Prim's algorithm grows each tree outward from a single vertex, always adding the
+// lightest edge that connects the tree to a vertex outside it. It runs in O(E log V)
+// time, and works through incident edge enumeration rather than loading all edges
+// at once.
+func Prim(g gogl.WeightedGraph) (forest gogl.WeightedEdgeList, weight float64, err error) {
+	intree := make(map[gogl.Vertex]bool)
+	forest = make(gogl.WeightedEdgeList, 0, gogl.Order(g))
+
+	// pushes all edges from v to vertices not yet in a tree onto the heap
+	expand := func(v gogl.Vertex, h *eheap) {
+		g.IncidentTo(v, func(e gogl.Edge) (terminate bool) {
+			we, ok := e.(gogl.WeightedEdge)
+			if !ok {
+				err = errors.New("Graph contains an edge that is not weighted.")
+				return true
+			}
+
+			u, w := e.Both()
+			if u == v {
+				u = w
+			}
+			if !intree[u] {
+				heap.Push(h, eitem{e: we, to: u})
+			}
+			return
+		})
+	}
+
+	g.Vertices(func(root gogl.Vertex) (terminate bool) {
+		if intree[root] {
+			return
+		}
+
+		intree[root] = true
+		h := &eheap{}
+		expand(root, h)
+
+		for h.Len() > 0 && err == nil {
+			item := heap.Pop(h).(eitem)
+			if intree[item.to] {
+				continue
+			}
+
+			intree[item.to] = true
+			forest = append(forest, item.e)
+			expand(item.to, h)
+		}
+
+		return err != nil
+	})
+
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return forest, totalWeight(forest), nil
+}
+
+// A candidate edge, along with the vertex it would add to the tree.
+type eitem struct {
+	e  gogl.WeightedEdge
+	to gogl.Vertex
+}
+
+// A min-heap of candidate edges, ordered by weight. Implements heap.Interface.
+type eheap []eitem
+
+func (h eheap) Len() int { return len(h) }
+
+func (h eheap) Less(i, j int) bool { return h[i].e.Weight() < h[j].e.Weight() }
+
+func (h eheap) Swap(i, j int) { h[i], h[j] = h[j], h[i] }
+
+func (h *eheap) Push(x interface{}) {
+	*h = append(*h, x.(eitem))
+}
+
+func (h *eheap) Pop() interface{} {
+	old := *h
+	n := len(old)
+	item := old[n-1]
+	*h = old[:n-1]
+	return item
+}
@@ -0,0 +1,232 @@
+// Contains a concurrent executor for work described by dependency graphs.
+package sched
+
+import (
+	"context"
+	"fmt"
+	"runtime"
+	"time"
+
+	"github.com/sdboyer/gogl"
+	"github.com/sdboyer/gogl/topo"
+)
+
+// A Task performs the work associated with a single vertex. The provided context is
+// cancelled if execution is aborted while the task is running; long-running tasks
+// should respect it.
+type Task func(ctx context.Context, v gogl.Vertex) error
+
+// Status describes the outcome of a single vertex's task.
+type Status int
+
+const (
+	// The task ran and returned a nil error.
+	Succeeded Status = iota
+	// The task ran and returned an error.
+	Failed
+	// The task did not run, because a task it depends on did not succeed.
+	Skipped
+	// The task did not run, because execution was aborted before it could begin.
+	Canceled
+)
+
+func (s Status) String() string {
+	switch s {
+	case Succeeded:
+		return "succeeded"
+	case Failed:
+		return "failed"
+	case Skipped:
+		return "skipped"
+	case Canceled:
+		return "canceled"
+	}
+	return fmt.Sprintf("Status(%d)", int(s))
+}
+
+// The Result of a single vertex's task.
+type Result struct {
+	Status Status
+	// The error returned by the task; nil unless Status is Failed.
+	Err error
+	// How long the task ran for; zero if it did not run.
+	Duration time.Duration
+}
+
+// A Report contains the Result for every vertex in an executed graph.
+type Report map[gogl.Vertex]Result
+
+// A TaskError is returned from Run when a task fails. It identifies the vertex whose
+// task failed, and wraps the task's error.
+type TaskError struct {
+	Vertex gogl.Vertex
+	Err    error
+}
+
+func (e TaskError) Error() string {
+	return fmt.Sprintf("Task for vertex %v failed: %s", e.Vertex, e.Err)
+}
+
+func (e TaskError) Unwrap() error {
+	return e.Err
+}
+
+// An Executor runs a Task for every vertex in a digraph, treating each arc as a
+// dependency: the task for an arc's target runs only after the task for its source
+// has succeeded. Tasks with no outstanding dependencies run concurrently.
+//
+// The zero value is ready to use, running up to GOMAXPROCS tasks at once and aborting
+// on the first failure.
+type Executor struct {
+	// The maximum number of tasks to run at once. If less than 1, GOMAXPROCS is used.
+	Workers int
+	// If true, a failed task does not abort execution; only those tasks that depend,
+	// directly or transitively, on the failed one are skipped. Otherwise, the context
+	// passed to running tasks is cancelled, and no further tasks are started.
+	KeepGoing bool
+}
+
+type completion struct {
+	v        gogl.Vertex
+	err      error
+	duration time.Duration
+}
+
+// Runs the provided task once for every vertex in the provided digraph, in dependency
+// order, using the Executor's settings.
+//
+// A Report is returned containing the outcome for every vertex. If any task fails, a
+// TaskError is returned for the first failure; if the provided context is cancelled
+// before all tasks complete, its error is returned. In either case, the report is
+// still complete.
+//
+// The graph must be acyclic. If it is not, no tasks are run, and a nil report is
+// returned along with a topo.CycleError.
+func (e Executor) Run(ctx context.Context, g gogl.Digraph, task Task) (Report, error) {
+	if _, err := topo.Sort(g, nil); err != nil {
+		return nil, err
+	}
+
+	workers := e.Workers
+	if workers < 1 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	pending := make(map[gogl.Vertex]int)
+	var ready []gogl.Vertex
+	g.Vertices(func(v gogl.Vertex) (terminate bool) {
+		pending[v] = 0
+		return
+	})
+	g.Arcs(func(a gogl.Arc) (terminate bool) {
+		pending[a.Target()]++
+		return
+	})
+	for v, deps := range pending {
+		if deps == 0 {
+			ready = append(ready, v)
+		}
+	}
+
+	jobs := make(chan gogl.Vertex)
+	done := make(chan completion)
+	for i := 0; i < workers; i++ {
+		go func() {
+			for v := range jobs {
+				start := time.Now()
+				err := task(ctx, v)
+				done <- completion{v: v, err: err, duration: time.Since(start)}
+			}
+		}()
+	}
+	defer close(jobs)
+
+	report := make(Report, len(pending))
+	var first error
+	var running int
+	aborted := ctx.Done()
+	stopping := false
+
+	for running > 0 || (len(ready) > 0 && !stopping) {
+		// A nil channel is never ready, so nothing is dispatched when there's nothing
+		// to dispatch, or once execution is stopping.
+		var dispatch chan gogl.Vertex
+		var next gogl.Vertex
+		if len(ready) > 0 && !stopping {
+			dispatch, next = jobs, ready[0]
+		}
+
+		select {
+		case dispatch <- next:
+			ready = ready[1:]
+			running++
+
+		case c := <-done:
+			running--
+			if c.err == nil {
+				report[c.v] = Result{Status: Succeeded, Duration: c.duration}
+				g.SuccessorsOf(c.v, func(w gogl.Vertex) (terminate bool) {
+					if pending[w]--; pending[w] == 0 && !stopping {
+						ready = append(ready, w)
+					}
+					return
+				})
+				continue
+			}
+
+			report[c.v] = Result{Status: Failed, Err: c.err, Duration: c.duration}
+			if first == nil {
+				first = TaskError{Vertex: c.v, Err: c.err}
+			}
+			skipDependents(g, c.v, report)
+
+			if !e.KeepGoing {
+				stopping = true
+				cancel()
+			}
+
+		case <-aborted:
+			aborted = nil
+			stopping = true
+		}
+	}
+
+	for v := range pending {
+		if _, exists := report[v]; !exists {
+			report[v] = Result{Status: Canceled}
+		}
+	}
+
+	if first == nil {
+		// Only the parent context can have been cancelled at this point.
+		for _, r := range report {
+			if r.Status == Canceled {
+				first = ctx.Err()
+				break
+			}
+		}
+	}
+
+	return report, first
+}
+
+// Marks every vertex reachable from the given failed vertex as skipped. None of them
+// can have run yet, as they all depend on the failed vertex.
+func skipDependents(g gogl.Digraph, failed gogl.Vertex, report Report) {
+	queue := []gogl.Vertex{failed}
+	for len(queue) > 0 {
+		v := queue[0]
+		queue = queue[1:]
+
+		g.SuccessorsOf(v, func(w gogl.Vertex) (terminate bool) {
+			if _, exists := report[w]; !exists {
+				report[w] = Result{Status: Skipped}
+				queue = append(queue, w)
+			}
+			return
+		})
+	}
+}
@@ -0,0 +1,219 @@
+package sched
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	. "github.com/sdboyer/gocheck"
+	"github.com/sdboyer/gogl"
+	"github.com/sdboyer/gogl/graph/al"
+	"github.com/sdboyer/gogl/topo"
+)
+
+// Hook gocheck into the go test runner
+func TestHookup(t *testing.T) { TestingT(t) }
+
+// Two independent chains joining at "link", plus an isolated branch off "fetch".
+var buildArcSet = gogl.ArcList{
+	gogl.NewArc("fetch", "compile"),
+	gogl.NewArc("configure", "compile"),
+	gogl.NewArc("configure", "docs"),
+	gogl.NewArc("compile", "test"),
+	gogl.NewArc("compile", "link"),
+	gogl.NewArc("test", "release"),
+	gogl.NewArc("link", "release"),
+	gogl.NewArc("docs", "release"),
+	gogl.NewArc("fetch", "lint"),
+}
+
+func buildGraph() gogl.Digraph {
+	return gogl.Spec().Directed().Using(buildArcSet).Create(al.G).(gogl.Digraph)
+}
+
+// Records the order in which tasks start and finish, and the peak concurrency.
+type recorder struct {
+	sync.Mutex
+	started  map[gogl.Vertex]int
+	finished map[gogl.Vertex]int
+	seq      int
+	active   int
+	peak     int
+	fail     map[gogl.Vertex]bool
+}
+
+func newRecorder(fail ...gogl.Vertex) *recorder {
+	r := &recorder{
+		started:  make(map[gogl.Vertex]int),
+		finished: make(map[gogl.Vertex]int),
+		fail:     make(map[gogl.Vertex]bool),
+	}
+	for _, v := range fail {
+		r.fail[v] = true
+	}
+	return r
+}
+
+func (r *recorder) task(ctx context.Context, v gogl.Vertex) error {
+	r.Lock()
+	r.seq++
+	r.started[v] = r.seq
+	r.active++
+	if r.active > r.peak {
+		r.peak = r.active
+	}
+	r.Unlock()
+
+	time.Sleep(time.Millisecond)
+
+	r.Lock()
+	defer r.Unlock()
+	r.seq++
+	r.finished[v] = r.seq
+	r.active--
+
+	if r.fail[v] {
+		return errors.New("boom")
+	}
+	return nil
+}
+
+type SchedSuite struct{}
+
+var _ = Suite(&SchedSuite{})
+
+func (s *SchedSuite) TestRun(c *C) {
+	g := buildGraph()
+	r := newRecorder()
+
+	report, err := Executor{Workers: 2}.Run(context.Background(), g, r.task)
+	c.Assert(err, IsNil)
+	c.Assert(len(report), Equals, gogl.Order(g))
+	for v, res := range report {
+		c.Assert(res.Status, Equals, Succeeded, Commentf("vertex %v", v))
+		c.Assert(res.Err, IsNil)
+		c.Assert(res.Duration > 0, Equals, true)
+	}
+
+	// every task started only after all its dependencies finished
+	g.Arcs(func(a gogl.Arc) (terminate bool) {
+		c.Assert(r.finished[a.Source()] < r.started[a.Target()], Equals, true, Commentf("arc %v", a))
+		return
+	})
+
+	c.Assert(r.peak <= 2, Equals, true)
+}
+
+func (s *SchedSuite) TestRunConcurrency(c *C) {
+	// Ten independent vertices; with enough workers, several should run at once.
+	g := gogl.Spec().Directed().Create(al.G).(gogl.VertexSetMutator)
+	for i := 0; i < 10; i++ {
+		g.EnsureVertex(i)
+	}
+	dg := g.(gogl.Digraph)
+
+	r := newRecorder()
+	report, err := Executor{Workers: 4}.Run(context.Background(), dg, r.task)
+	c.Assert(err, IsNil)
+	c.Assert(len(report), Equals, 10)
+	c.Assert(r.peak > 1, Equals, true)
+	c.Assert(r.peak <= 4, Equals, true)
+
+	r = newRecorder()
+	_, err = Executor{Workers: 1}.Run(context.Background(), dg, r.task)
+	c.Assert(err, IsNil)
+	c.Assert(r.peak, Equals, 1)
+}
+
+func (s *SchedSuite) TestRunFailFast(c *C) {
+	g := buildGraph()
+	r := newRecorder("compile")
+
+	report, err := Executor{Workers: 1}.Run(context.Background(), g, r.task)
+	c.Assert(err, ErrorMatches, "Task for vertex compile failed: boom")
+
+	te, ok := err.(TaskError)
+	c.Assert(ok, Equals, true)
+	c.Assert(te.Vertex, Equals, "compile")
+	c.Assert(errors.Unwrap(err), ErrorMatches, "boom")
+
+	c.Assert(len(report), Equals, gogl.Order(g))
+	c.Assert(report["compile"].Status, Equals, Failed)
+	c.Assert(report["compile"].Err, ErrorMatches, "boom")
+	for _, v := range []string{"test", "link", "release"} {
+		c.Assert(report[v].Status, Equals, Skipped, Commentf("vertex %v", v))
+	}
+
+	// nothing starts after the failure
+	for v, res := range report {
+		if res.Status == Succeeded {
+			c.Assert(r.started[v] < r.finished["compile"], Equals, true)
+		}
+		if res.Status == Canceled {
+			_, ran := r.started[v]
+			c.Assert(ran, Equals, false)
+		}
+	}
+}
+
+func (s *SchedSuite) TestRunKeepGoing(c *C) {
+	g := buildGraph()
+	r := newRecorder("compile")
+
+	report, err := Executor{Workers: 3, KeepGoing: true}.Run(context.Background(), g, r.task)
+	c.Assert(err, ErrorMatches, "Task for vertex compile failed: boom")
+
+	expected := map[gogl.Vertex]Status{
+		"fetch":     Succeeded,
+		"configure": Succeeded,
+		"docs":      Succeeded,
+		"lint":      Succeeded,
+		"compile":   Failed,
+		"test":      Skipped,
+		"link":      Skipped,
+		"release":   Skipped,
+	}
+	c.Assert(len(report), Equals, len(expected))
+	for v, status := range expected {
+		c.Assert(report[v].Status, Equals, status, Commentf("vertex %v", v))
+	}
+}
+
+func (s *SchedSuite) TestRunCancelContext(c *C) {
+	g := buildGraph()
+	ctx, cancel := context.WithCancel(context.Background())
+
+	report, err := Executor{Workers: 1}.Run(ctx, g, func(ctx context.Context, v gogl.Vertex) error {
+		if v == "compile" {
+			cancel()
+			<-ctx.Done()
+		}
+		return nil
+	})
+	c.Assert(err, Equals, context.Canceled)
+	c.Assert(report["compile"].Status, Equals, Succeeded)
+	c.Assert(report["release"].Status, Equals, Canceled)
+}
+
+func (s *SchedSuite) TestRunCycle(c *C) {
+	el := append(gogl.ArcList{gogl.NewArc("release", "fetch")}, buildArcSet...)
+	g := gogl.Spec().Directed().Using(el).Create(al.G).(gogl.Digraph)
+
+	var ran bool
+	report, err := Executor{}.Run(context.Background(), g, func(ctx context.Context, v gogl.Vertex) error {
+		ran = true
+		return nil
+	})
+	c.Assert(report, IsNil)
+	c.Assert(ran, Equals, false)
+	_, ok := err.(topo.CycleError)
+	c.Assert(ok, Equals, true)
+}
+
+func (s *SchedSuite) TestStatusString(c *C) {
+	c.Assert(Succeeded.String(), Equals, "succeeded")
+	c.Assert(Canceled.String(), Equals, "canceled")
+	c.Assert(Status(9).String(), Equals, "Status(9)")
+}
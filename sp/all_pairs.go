@@ -0,0 +1,116 @@
+package sp
+
+import (
+	"errors"
+	"math"
+
+	"github.com/sdboyer/gogl"
+)
+
+// AllPairs holds the result of an all-pairs shortest path calculation, as produced
+// by FloydWarshall or Johnson.
+//
+// Internally, vertices are mapped to dense integer indices, and distances and
+// predecessors are held in V x V matrices. Memory use is therefore proportional
+// to the square of the graph's order, regardless of its size.
+type AllPairs struct {
+	vertices []gogl.Vertex
+	index    map[gogl.Vertex]int
+	dist     [][]float64 // +Inf where no path exists
+	pred     [][]int     // pred[i][j] is j's predecessor on the path from i; -1 where no path exists
+}
+
+// Returns the total weight of the shortest path from u to v.
+//
+// If either vertex is not present in the graph, or there is no path from u to v,
+// the second return value will be false and the distance will be +Inf.
+func (ap *AllPairs) Distance(u, v gogl.Vertex) (distance float64, exists bool) {
+	i, iok := ap.index[u]
+	j, jok := ap.index[v]
+	if !iok || !jok {
+		return math.Inf(1), false
+	}
+
+	return ap.dist[i][j], ap.pred[i][j] != -1
+}
+
+// Returns the shortest path from u to v, ordered from v back to u.
+//
+// If either vertex is not present in the graph, or there is no path from u to v,
+// the returned slice is nil and an error is returned instead.
+func (ap *AllPairs) Path(u, v gogl.Vertex) ([]gogl.Vertex, error) {
+	i, exists := ap.index[u]
+	if !exists {
+		return nil, errors.New("Start vertex is not present in graph.")
+	}
+	j, exists := ap.index[v]
+	if !exists {
+		return nil, errors.New("Target vertex is not present in graph.")
+	}
+	if ap.pred[i][j] == -1 {
+		return nil, errors.New("Target vertex is not reachable from the start vertex.")
+	}
+
+	path := []gogl.Vertex{v}
+	for j != i {
+		j = ap.pred[i][j]
+		path = append(path, ap.vertices[j])
+	}
+
+	return path, nil
+}
+
+// Indexes the vertices of the provided graph and collects its edges as weighted arcs,
+// in preparation for an all-pairs calculation. Each edge of an undirected graph is
+// represented by a pair of opposing arcs.
+//
+// The returned AllPairs has its matrices allocated, but not populated.
+func newAllPairs(g gogl.WeightedGraph) (ap *AllPairs, arcs []gogl.WeightedArc, err error) {
+	order := gogl.Order(g)
+	ap = &AllPairs{
+		vertices: make([]gogl.Vertex, 0, order),
+		index:    make(map[gogl.Vertex]int, order),
+		dist:     make([][]float64, order),
+		pred:     make([][]int, order),
+	}
+
+	g.Vertices(func(v gogl.Vertex) (terminate bool) {
+		ap.index[v] = len(ap.vertices)
+		ap.vertices = append(ap.vertices, v)
+		return
+	})
+
+	for i := range ap.dist {
+		ap.dist[i] = make([]float64, order)
+		ap.pred[i] = make([]int, order)
+	}
+
+	if dg, ok := g.(gogl.Digraph); ok {
+		dg.Arcs(func(a gogl.Arc) (terminate bool) {
+			wa, ok := a.(gogl.WeightedArc)
+			if !ok {
+				err = errors.New("Graph contains an arc that is not weighted.")
+				return true
+			}
+			arcs = append(arcs, wa)
+			return
+		})
+	} else {
+		g.Edges(func(e gogl.Edge) (terminate bool) {
+			we, ok := e.(gogl.WeightedEdge)
+			if !ok {
+				err = errors.New("Graph contains an edge that is not weighted.")
+				return true
+			}
+			u, v := e.Both()
+			arcs = append(arcs, gogl.NewWeightedArc(u, v, we.Weight()), gogl.NewWeightedArc(v, u, we.Weight()))
+			return
+		})
+	}
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return ap, arcs, nil
+}
@@ -0,0 +1,127 @@
+package sp
+
+import (
+	"math"
+
+	. "github.com/sdboyer/gocheck"
+	"github.com/sdboyer/gogl"
+	"github.com/sdboyer/gogl/graph/al"
+)
+
+type AllPairsSuite struct {
+	algos map[string]func(gogl.WeightedGraph) (*AllPairs, error)
+}
+
+var _ = Suite(&AllPairsSuite{
+	algos: map[string]func(gogl.WeightedGraph) (*AllPairs, error){
+		"FloydWarshall": FloydWarshall,
+		"Johnson":       Johnson,
+	},
+})
+
+func (s *AllPairsSuite) TestAgreesWithDijkstra(c *C) {
+	graphs := []gogl.WeightedGraph{
+		gogl.Spec().Directed().Weighted().Using(spArcSet).Create(al.G).(gogl.WeightedGraph),
+		gogl.Spec().Weighted().Using(spEdgeSet).Create(al.G).(gogl.WeightedGraph),
+	}
+
+	for name, algo := range s.algos {
+		for _, g := range graphs {
+			ap, err := algo(g)
+			c.Assert(err, IsNil)
+
+			g.Vertices(func(u gogl.Vertex) (terminate bool) {
+				dist, _, err := Dijkstra(g, u)
+				c.Assert(err, IsNil)
+
+				g.Vertices(func(v gogl.Vertex) (terminate bool) {
+					d, exists := ap.Distance(u, v)
+					if expected, reachable := dist[v]; reachable {
+						c.Assert(exists, Equals, true)
+						c.Assert(d, Equals, expected, Commentf("%s: %v to %v", name, u, v))
+
+						path, err := ap.Path(u, v)
+						c.Assert(err, IsNil)
+						c.Assert(path[0], Equals, v)
+						c.Assert(path[len(path)-1], Equals, u)
+					} else {
+						c.Assert(exists, Equals, false)
+						c.Assert(math.IsInf(d, 1), Equals, true)
+
+						_, err := ap.Path(u, v)
+						c.Assert(err, ErrorMatches, "Target vertex is not reachable.*")
+					}
+					return
+				})
+				return
+			})
+		}
+	}
+}
+
+func (s *AllPairsSuite) TestNegativeWeights(c *C) {
+	el := gogl.WeightedArcList{
+		gogl.NewWeightedArc("s", "a", 4),
+		gogl.NewWeightedArc("s", "b", 5),
+		gogl.NewWeightedArc("b", "a", -3),
+		gogl.NewWeightedArc("a", "c", 2),
+		gogl.NewWeightedArc("c", "d", -1),
+	}
+	g := gogl.Spec().Directed().Weighted().Using(el).Create(al.G).(gogl.WeightedGraph)
+
+	for _, algo := range s.algos {
+		ap, err := algo(g)
+		c.Assert(err, IsNil)
+
+		d, exists := ap.Distance("s", "d")
+		c.Assert(exists, Equals, true)
+		c.Assert(d, Equals, float64(3))
+
+		d, exists = ap.Distance("b", "c")
+		c.Assert(exists, Equals, true)
+		c.Assert(d, Equals, float64(-1))
+
+		path, err := ap.Path("s", "d")
+		c.Assert(err, IsNil)
+		c.Assert(path, DeepEquals, []gogl.Vertex{"d", "c", "a", "b", "s"})
+
+		_, exists = ap.Distance("nope", "d")
+		c.Assert(exists, Equals, false)
+		_, err = ap.Path("nope", "d")
+		c.Assert(err, ErrorMatches, "Start vertex.*")
+		_, err = ap.Path("s", "nope")
+		c.Assert(err, ErrorMatches, "Target vertex.*")
+	}
+}
+
+func (s *AllPairsSuite) TestNegativeCycle(c *C) {
+	el := gogl.WeightedArcList{
+		gogl.NewWeightedArc("s", "a", 1),
+		gogl.NewWeightedArc("a", "b", 1),
+		gogl.NewWeightedArc("b", "c", -2),
+		gogl.NewWeightedArc("c", "a", -1),
+	}
+	g := gogl.Spec().Directed().Weighted().Using(el).Create(al.G).(gogl.WeightedGraph)
+
+	for _, algo := range s.algos {
+		ap, err := algo(g)
+		c.Assert(ap, IsNil)
+
+		nce, ok := err.(NegativeCycleError)
+		c.Assert(ok, Equals, true)
+		c.Assert(len(nce.Cycle), Equals, 3)
+	}
+
+	// in an undirected graph, a single negative edge is a cycle
+	ug := gogl.Spec().Weighted().Using(gogl.WeightedEdgeList{
+		gogl.NewWeightedEdge(1, 2, 3),
+		gogl.NewWeightedEdge(2, 3, -1),
+	}).Create(al.G).(gogl.WeightedGraph)
+
+	for _, algo := range s.algos {
+		_, err := algo(ug)
+		nce, ok := err.(NegativeCycleError)
+		c.Assert(ok, Equals, true)
+		c.Assert(len(nce.Cycle), Equals, 2)
+	}
+}
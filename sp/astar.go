@@ -0,0 +1,81 @@
+package sp
+
+import (
+	"errors"
+
+	"github.com/sdboyer/gogl"
+)
+
+// A Heuristic estimates the cost of the cheapest path from the given vertex to the
+// target of an A* search.
+//
+// For A* to be guaranteed to find the cheapest path, the heuristic must be admissible:
+// it must never overestimate the true remaining cost. A heuristic that always returns
+// 0 is admissible, and reduces A* to Dijkstra's algorithm.
+type Heuristic func(v gogl.Vertex) float64
+
+// Performs an A* search for the given target vertex in the provided graph, beginning
+// from the given start vertex and guided by the provided heuristic.
+//
+// A slice of vertices is returned, identifying the path from the start to the target vertex.
+// As with dfs.Search, the path is ordered from the target vertex back to the start vertex.
+// The total weight of the path is returned as well. If no path can be found, the returned
+// slice is nil and an error is returned instead.
+//
+// Like Dijkstra, A* requires non-negative edge weights; if a negative weight is encountered
+// during the search, an error is returned.
+func AStar(g gogl.WeightedGraph, target gogl.Vertex, start gogl.Vertex, h Heuristic) (path []gogl.Vertex, cost float64, err error) {
+	if !g.HasVertex(target) {
+		return nil, 0, errors.New("Target vertex is not present in graph.")
+	}
+	if !g.HasVertex(start) {
+		return nil, 0, errors.New("Start vertex is not present in graph.")
+	}
+
+	gscore := map[gogl.Vertex]float64{start: 0}
+	fscore := map[gogl.Vertex]float64{start: h(start)}
+	pred := PredecessorTree{start: start}
+	neighbors := weightedNeighborEnumerator(g)
+
+	open := &vheap{}
+	open.push(start, fscore[start])
+
+	for open.Len() > 0 {
+		item := open.pop()
+		// Skip entries superseded by a cheaper path found after they were pushed.
+		if item.priority > fscore[item.v] {
+			continue
+		}
+
+		if item.v == target {
+			path, _ = pred.PathTo(target)
+			return path, gscore[target], nil
+		}
+
+		var negative bool
+		err = neighbors(item.v, func(adj gogl.Vertex, weight float64) bool {
+			if weight < 0 {
+				negative = true
+				return true
+			}
+
+			tentative := gscore[item.v] + weight
+			if known, seen := gscore[adj]; !seen || tentative < known {
+				gscore[adj] = tentative
+				fscore[adj] = tentative + h(adj)
+				pred[adj] = item.v
+				open.push(adj, fscore[adj])
+			}
+			return false
+		})
+
+		if negative {
+			err = errors.New("Negative edge weight encountered; A* requires non-negative weights.")
+		}
+		if err != nil {
+			return nil, 0, err
+		}
+	}
+
+	return nil, 0, errors.New("No path exists from the start vertex to the target vertex.")
+}
@@ -0,0 +1,101 @@
+package sp
+
+import (
+	"math"
+
+	. "github.com/sdboyer/gocheck"
+	"github.com/sdboyer/gogl"
+	"github.com/sdboyer/gogl/graph/al"
+)
+
+type point struct {
+	x, y int
+}
+
+// Builds a w x h grid with unit-weight edges, omitting the cells in walls.
+func gridGraph(w, h int, walls ...point) gogl.WeightedGraph {
+	blocked := make(map[point]bool)
+	for _, p := range walls {
+		blocked[p] = true
+	}
+
+	var el gogl.WeightedEdgeList
+	for x := 0; x < w; x++ {
+		for y := 0; y < h; y++ {
+			p := point{x, y}
+			if blocked[p] {
+				continue
+			}
+			if right := (point{x + 1, y}); x+1 < w && !blocked[right] {
+				el = append(el, gogl.NewWeightedEdge(p, right, 1))
+			}
+			if down := (point{x, y + 1}); y+1 < h && !blocked[down] {
+				el = append(el, gogl.NewWeightedEdge(p, down, 1))
+			}
+		}
+	}
+
+	return gogl.Spec().Weighted().Using(el).Create(al.G).(gogl.WeightedGraph)
+}
+
+func manhattan(to point) Heuristic {
+	return func(v gogl.Vertex) float64 {
+		p := v.(point)
+		return math.Abs(float64(p.x-to.x)) + math.Abs(float64(p.y-to.y))
+	}
+}
+
+type AStarSuite struct{}
+
+var _ = Suite(&AStarSuite{})
+
+func (s *AStarSuite) TestGrid(c *C) {
+	// a wall down the middle with a single gap at the bottom
+	g := gridGraph(5, 5, point{2, 0}, point{2, 1}, point{2, 2}, point{2, 3})
+	start, target := point{0, 0}, point{4, 0}
+
+	path, cost, err := AStar(g, target, start, manhattan(target))
+	c.Assert(err, IsNil)
+	c.Assert(cost, Equals, float64(12))
+	c.Assert(len(path), Equals, 13)
+	c.Assert(path[0], Equals, target)
+	c.Assert(path[len(path)-1], Equals, start)
+	c.Assert(path, Contains, point{2, 4})
+
+	// a zero heuristic must agree with Dijkstra
+	zero := func(v gogl.Vertex) float64 { return 0 }
+	_, zcost, err := AStar(g, target, start, zero)
+	c.Assert(err, IsNil)
+	dist, _, _ := Dijkstra(g, start)
+	c.Assert(zcost, Equals, dist[target])
+}
+
+func (s *AStarSuite) TestWeighted(c *C) {
+	g := gogl.Spec().Directed().Weighted().Using(spArcSet).Create(al.G).(gogl.WeightedGraph)
+
+	path, cost, err := AStar(g, 5, 1, func(v gogl.Vertex) float64 { return 0 })
+	c.Assert(err, IsNil)
+	c.Assert(cost, Equals, float64(20))
+	c.Assert(path, DeepEquals, []gogl.Vertex{5, 6, 3, 1})
+}
+
+func (s *AStarSuite) TestErrors(c *C) {
+	g := gridGraph(3, 3, point{1, 0}, point{1, 1}, point{1, 2})
+	h := manhattan(point{2, 2})
+
+	_, _, err := AStar(g, point{2, 2}, point{9, 9}, h)
+	c.Assert(err, ErrorMatches, "Start vertex.*")
+	_, _, err = AStar(g, point{9, 9}, point{0, 0}, h)
+	c.Assert(err, ErrorMatches, "Target vertex.*")
+
+	path, _, err := AStar(g, point{2, 2}, point{0, 0}, h)
+	c.Assert(path, IsNil)
+	c.Assert(err, ErrorMatches, "No path exists.*")
+
+	neg := gogl.Spec().Weighted().Using(gogl.WeightedEdgeList{
+		gogl.NewWeightedEdge(1, 2, -1),
+		gogl.NewWeightedEdge(2, 3, 1),
+	}).Create(al.G).(gogl.WeightedGraph)
+	_, _, err = AStar(neg, 3, 1, func(v gogl.Vertex) float64 { return 0 })
+	c.Assert(err, ErrorMatches, "Negative edge weight.*")
+}
@@ -0,0 +1,132 @@
+package sp
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/sdboyer/gogl"
+)
+
+// A NegativeCycleError is returned when a shortest path search finds a cycle whose
+// total weight is negative; no shortest path exists to any vertex reachable from it.
+//
+// The offending cycle is given in arc order: each vertex has an arc to the next,
+// and the last vertex has an arc back to the first.
+type NegativeCycleError struct {
+	Cycle []gogl.Vertex
+}
+
+func (e NegativeCycleError) Error() string {
+	return fmt.Sprintf("Negative cycle detected in graph: %v", e.Cycle)
+}
+
+// Runs the Bellman-Ford algorithm over the provided digraph, calculating the shortest
+// path from the given source vertex to every vertex reachable from it.
+//
+// Unlike Dijkstra, Bellman-Ford correctly handles negative arc weights. It does so at
+// a cost of O(VE) time, and relies only on full enumeration of the graph's vertices and
+// arcs; thus it works equally well on a full WeightedDigraph or a naive WeightedArcList.
+// All arcs must implement WeightedArc, or an error is returned.
+//
+// If a cycle of negative total weight is reachable from the source, a NegativeCycleError
+// is returned containing that cycle.
+func BellmanFord(g gogl.DigraphSource, source gogl.Vertex) (dist map[gogl.Vertex]float64, pred PredecessorTree, err error) {
+	if !hasVertex(g, source) {
+		return nil, nil, errors.New("Source vertex is not present in graph.")
+	}
+
+	arcs := make([]gogl.WeightedArc, 0, gogl.Size(g))
+	g.Arcs(func(a gogl.Arc) (terminate bool) {
+		if wa, ok := a.(gogl.WeightedArc); ok {
+			arcs = append(arcs, wa)
+		} else {
+			err = errors.New("Graph contains an arc that is not weighted.")
+			terminate = true
+		}
+		return
+	})
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	order := gogl.Order(g)
+	dist, pred, cyclic, found := bellmanFord(arcs, order, source)
+	if found {
+		return nil, nil, NegativeCycleError{Cycle: negativeCycle(pred, order, cyclic)}
+	}
+
+	return dist, pred, nil
+}
+
+// Performs the relaxation passes of Bellman-Ford over a weighted arc slice.
+//
+// If a final pass still finds a relaxable arc, there must be a negative cycle; the
+// head of that arc is returned in the third return value, and the fourth is true.
+func bellmanFord(arcs []gogl.WeightedArc, order int, source gogl.Vertex) (dist map[gogl.Vertex]float64, pred PredecessorTree, cyclic gogl.Vertex, found bool) {
+	dist = map[gogl.Vertex]float64{source: 0}
+	pred = PredecessorTree{source: source}
+
+	relax := func() (relaxed gogl.Vertex, changed bool) {
+		for _, a := range arcs {
+			u, v := a.Both()
+			du, reached := dist[u]
+			if !reached {
+				continue
+			}
+
+			alt := du + a.Weight()
+			if dv, seen := dist[v]; !seen || alt < dv {
+				dist[v] = alt
+				pred[v] = u
+				relaxed, changed = v, true
+			}
+		}
+		return
+	}
+
+	for i := 1; i < order; i++ {
+		if _, changed := relax(); !changed {
+			return dist, pred, nil, false
+		}
+	}
+
+	cyclic, found = relax()
+	return
+}
+
+// Extracts a negative cycle from the predecessor tree, given a vertex that was still
+// relaxable after all Bellman-Ford passes completed.
+func negativeCycle(pred PredecessorTree, order int, v gogl.Vertex) []gogl.Vertex {
+	// Stepping back through predecessors once per vertex guarantees we land on the cycle,
+	// rather than on a path leading into it.
+	for i := 0; i < order; i++ {
+		v = pred[v]
+	}
+
+	cycle := []gogl.Vertex{v}
+	for u := pred[v]; u != v; u = pred[u] {
+		cycle = append(cycle, u)
+	}
+
+	// The walk above follows arcs backwards; reverse it into arc order.
+	for i, j := 0, len(cycle)-1; i < j; i, j = i+1, j-1 {
+		cycle[i], cycle[j] = cycle[j], cycle[i]
+	}
+
+	return cycle
+}
+
+// Indicates whether the given vertex is present in the graph, using the HasVertex
+// method if the graph provides it, and a full vertex enumeration otherwise.
+func hasVertex(g gogl.VertexEnumerator, v gogl.Vertex) (exists bool) {
+	if c, ok := g.(gogl.VertexMembershipChecker); ok {
+		return c.HasVertex(v)
+	}
+
+	g.Vertices(func(vtx gogl.Vertex) bool {
+		exists = vtx == v
+		return exists
+	})
+	return
+}
@@ -0,0 +1,91 @@
+package sp
+
+import (
+	. "github.com/sdboyer/gocheck"
+	"github.com/sdboyer/gogl"
+	"github.com/sdboyer/gogl/graph/al"
+)
+
+type BellmanFordSuite struct{}
+
+var _ = Suite(&BellmanFordSuite{})
+
+func (s *BellmanFordSuite) TestNonNegative(c *C) {
+	// Should agree with Dijkstra when there are no negative weights.
+	g := gogl.Spec().Directed().Weighted().Using(spArcSet).Create(al.G).(gogl.WeightedDigraph)
+
+	dist, pred, err := BellmanFord(g, 1)
+	c.Assert(err, IsNil)
+	c.Assert(dist, DeepEquals, map[gogl.Vertex]float64{1: 0, 2: 7, 3: 9, 4: 20, 5: 20, 6: 11})
+
+	path, err := pred.PathTo(5)
+	c.Assert(err, IsNil)
+	c.Assert(path, DeepEquals, []gogl.Vertex{5, 6, 3, 1})
+}
+
+func (s *BellmanFordSuite) TestNegativeWeights(c *C) {
+	// runs directly on the arc list, no graph needed
+	el := gogl.WeightedArcList{
+		gogl.NewWeightedArc("s", "a", 4),
+		gogl.NewWeightedArc("s", "b", 5),
+		gogl.NewWeightedArc("b", "a", -3),
+		gogl.NewWeightedArc("a", "c", 2),
+		gogl.NewWeightedArc("c", "d", -1),
+		gogl.NewWeightedArc("x", "s", 1),
+	}
+
+	dist, pred, err := BellmanFord(el, "s")
+	c.Assert(err, IsNil)
+	c.Assert(dist, DeepEquals, map[gogl.Vertex]float64{"s": 0, "a": 2, "b": 5, "c": 4, "d": 3})
+
+	path, err := pred.PathTo("d")
+	c.Assert(err, IsNil)
+	c.Assert(path, DeepEquals, []gogl.Vertex{"d", "c", "a", "b", "s"})
+
+	_, err = pred.PathTo("x")
+	c.Assert(err, NotNil)
+}
+
+func (s *BellmanFordSuite) TestNegativeCycle(c *C) {
+	el := gogl.WeightedArcList{
+		gogl.NewWeightedArc("s", "a", 1),
+		gogl.NewWeightedArc("a", "b", 1),
+		gogl.NewWeightedArc("b", "c", -2),
+		gogl.NewWeightedArc("c", "a", -1),
+		gogl.NewWeightedArc("c", "d", 1),
+	}
+
+	dist, pred, err := BellmanFord(el, "s")
+	c.Assert(dist, IsNil)
+	c.Assert(pred, IsNil)
+
+	nce, ok := err.(NegativeCycleError)
+	c.Assert(ok, Equals, true)
+	c.Assert(len(nce.Cycle), Equals, 3)
+	c.Assert(err, ErrorMatches, "Negative cycle detected in graph.*")
+
+	// the cycle must follow the arcs, in order, and wrap around
+	for i, v := range nce.Cycle {
+		next := nce.Cycle[(i+1)%len(nce.Cycle)]
+		found := false
+		for _, a := range el {
+			if a.Source() == v && a.Target() == next {
+				found = true
+			}
+		}
+		c.Assert(found, Equals, true)
+	}
+
+	// an unreachable negative cycle is not a problem
+	dist, _, err = BellmanFord(el, "d")
+	c.Assert(err, IsNil)
+	c.Assert(dist, DeepEquals, map[gogl.Vertex]float64{"d": 0})
+}
+
+func (s *BellmanFordSuite) TestErrors(c *C) {
+	_, _, err := BellmanFord(spArcSet, "nope")
+	c.Assert(err, ErrorMatches, "Source vertex is not present in graph.")
+
+	_, _, err = BellmanFord(gogl.ArcList{gogl.NewArc(1, 2)}, 1)
+	c.Assert(err, ErrorMatches, ".*not weighted.*")
+}
@@ -0,0 +1,64 @@
+package sp
+
+import (
+	"errors"
+
+	"github.com/sdboyer/gogl"
+)
+
+// Runs Dijkstra's algorithm over the provided graph, calculating the shortest path from
+// the given source vertex to every vertex reachable from it.
+//
+// The returned distance map contains the total weight of the shortest path to each
+// reachable vertex; the returned PredecessorTree can be used to reconstruct those paths.
+// Vertices unreachable from the source are present in neither.
+//
+// Dijkstra's algorithm is only correct for graphs with non-negative edge weights. If a
+// negative weight is encountered during the search, an error is returned; BellmanFord
+// should be used for such graphs instead.
+func Dijkstra(g gogl.WeightedGraph, source gogl.Vertex) (dist map[gogl.Vertex]float64, pred PredecessorTree, err error) {
+	if !g.HasVertex(source) {
+		return nil, nil, errors.New("Source vertex is not present in graph.")
+	}
+
+	dist = map[gogl.Vertex]float64{source: 0}
+	pred = PredecessorTree{source: source}
+	done := make(map[gogl.Vertex]struct{})
+	neighbors := weightedNeighborEnumerator(g)
+
+	h := &vheap{}
+	h.push(source, 0)
+
+	for h.Len() > 0 {
+		item := h.pop()
+		if _, finished := done[item.v]; finished {
+			continue
+		}
+		done[item.v] = struct{}{}
+
+		var negative bool
+		err = neighbors(item.v, func(adj gogl.Vertex, weight float64) bool {
+			if weight < 0 {
+				negative = true
+				return true
+			}
+
+			alt := item.priority + weight
+			if d, seen := dist[adj]; !seen || alt < d {
+				dist[adj] = alt
+				pred[adj] = item.v
+				h.push(adj, alt)
+			}
+			return false
+		})
+
+		if negative {
+			err = errors.New("Negative edge weight encountered; Dijkstra's algorithm requires non-negative weights.")
+		}
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	return dist, pred, nil
+}
@@ -0,0 +1,57 @@
+package sp
+
+import (
+	. "github.com/sdboyer/gocheck"
+	"github.com/sdboyer/gogl"
+	"github.com/sdboyer/gogl/graph/al"
+)
+
+type DijkstraSuite struct{}
+
+var _ = Suite(&DijkstraSuite{})
+
+func (s *DijkstraSuite) TestDirected(c *C) {
+	g := gogl.Spec().Directed().Weighted().Using(spArcSet).Create(al.G).(gogl.WeightedGraph)
+
+	dist, pred, err := Dijkstra(g, 1)
+	c.Assert(err, IsNil)
+	c.Assert(dist, DeepEquals, map[gogl.Vertex]float64{1: 0, 2: 7, 3: 9, 4: 20, 5: 20, 6: 11})
+
+	path, err := pred.PathTo(5)
+	c.Assert(err, IsNil)
+	c.Assert(path, DeepEquals, []gogl.Vertex{5, 6, 3, 1})
+
+	// nothing is reachable from the sink
+	dist, pred, err = Dijkstra(g, 5)
+	c.Assert(err, IsNil)
+	c.Assert(dist, DeepEquals, map[gogl.Vertex]float64{5: 0})
+	_, err = pred.PathTo(1)
+	c.Assert(err, NotNil)
+}
+
+func (s *DijkstraSuite) TestUndirected(c *C) {
+	g := gogl.Spec().Weighted().Using(spEdgeSet).Create(al.G).(gogl.WeightedGraph)
+
+	dist, pred, err := Dijkstra(g, 5)
+	c.Assert(err, IsNil)
+	c.Assert(dist, DeepEquals, map[gogl.Vertex]float64{1: 20, 2: 21, 3: 11, 4: 6, 5: 0, 6: 9})
+
+	path, err := pred.PathTo(1)
+	c.Assert(err, IsNil)
+	c.Assert(path, DeepEquals, []gogl.Vertex{1, 3, 6, 5})
+}
+
+func (s *DijkstraSuite) TestErrors(c *C) {
+	g := gogl.Spec().Directed().Weighted().Using(spArcSet).Create(al.G).(gogl.WeightedGraph)
+
+	_, _, err := Dijkstra(g, "nope")
+	c.Assert(err, ErrorMatches, "Source vertex is not present in graph.")
+
+	neg := append(gogl.WeightedArcList{gogl.NewWeightedArc(6, 1, -3)}, spArcSet...)
+	g = gogl.Spec().Directed().Weighted().Using(neg).Create(al.G).(gogl.WeightedGraph)
+
+	dist, pred, err := Dijkstra(g, 1)
+	c.Assert(dist, IsNil)
+	c.Assert(pred, IsNil)
+	c.Assert(err, ErrorMatches, "Negative edge weight.*")
+}
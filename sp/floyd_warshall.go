@@ -0,0 +1,72 @@
+package sp
+
+import (
+	"math"
+
+	"github.com/sdboyer/gogl"
+)
+
+// Runs the Floyd-Warshall algorithm over the provided graph, calculating the shortest
+// path between every pair of vertices.
+//
+// Floyd-Warshall runs in O(V^3) time regardless of the number of edges, making it
+// best suited to dense graphs; for sparse graphs, Johnson will typically be faster.
+//
+// Negative weights are permitted. Note, however, that a negatively weighted edge in
+// an undirected graph constitutes a negative cycle on its own. If the graph contains
+// a negative cycle, a NegativeCycleError is returned containing that cycle.
+func FloydWarshall(g gogl.WeightedGraph) (*AllPairs, error) {
+	ap, arcs, err := newAllPairs(g)
+	if err != nil {
+		return nil, err
+	}
+
+	n := len(ap.vertices)
+	inf := math.Inf(1)
+
+	for i := 0; i < n; i++ {
+		for j := 0; j < n; j++ {
+			ap.dist[i][j] = inf
+			ap.pred[i][j] = -1
+		}
+		ap.dist[i][i] = 0
+		ap.pred[i][i] = i
+	}
+
+	for _, a := range arcs {
+		i, j := ap.index[a.Source()], ap.index[a.Target()]
+		if a.Weight() < ap.dist[i][j] {
+			ap.dist[i][j] = a.Weight()
+			ap.pred[i][j] = i
+		}
+	}
+
+	for k := 0; k < n; k++ {
+		dk := ap.dist[k]
+		for i := 0; i < n; i++ {
+			dik := ap.dist[i][k]
+			if math.IsInf(dik, 1) {
+				continue
+			}
+
+			di := ap.dist[i]
+			for j := 0; j < n; j++ {
+				if alt := dik + dk[j]; alt < di[j] {
+					di[j] = alt
+					ap.pred[i][j] = ap.pred[k][j]
+				}
+			}
+		}
+	}
+
+	for i := 0; i < n; i++ {
+		if ap.dist[i][i] < 0 {
+			// The matrices are no help in recovering the cycle itself, so defer to Bellman-Ford,
+			// which is guaranteed to find a negative cycle reachable from this vertex.
+			_, pred, cyclic, _ := bellmanFord(arcs, n, ap.vertices[i])
+			return nil, NegativeCycleError{Cycle: negativeCycle(pred, n, cyclic)}
+		}
+	}
+
+	return ap, nil
+}
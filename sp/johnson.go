@@ -0,0 +1,104 @@
+package sp
+
+import (
+	"math"
+
+	"github.com/sdboyer/gogl"
+)
+
+// The vertex temporarily connected to every other vertex during Johnson's reweighting
+// step. Being unexported, it cannot collide with any vertex in a client's graph.
+type johnsonSource struct{}
+
+// An arc between dense vertex indices.
+type indexedArc struct {
+	to int
+	w  float64
+}
+
+// Runs Johnson's algorithm over the provided graph, calculating the shortest path
+// between every pair of vertices.
+//
+// Johnson's algorithm uses a single Bellman-Ford pass to reweight the graph's edges
+// so that they are all non-negative, then runs Dijkstra from every vertex. It runs in
+// O(VE log V) time, making it better suited than FloydWarshall to sparse graphs.
+//
+// Negative weights are permitted. Note, however, that a negatively weighted edge in
+// an undirected graph constitutes a negative cycle on its own. If the graph contains
+// a negative cycle, a NegativeCycleError is returned containing that cycle.
+func Johnson(g gogl.WeightedGraph) (*AllPairs, error) {
+	ap, arcs, err := newAllPairs(g)
+	if err != nil {
+		return nil, err
+	}
+
+	n := len(ap.vertices)
+
+	// Connect the extra source to every vertex with a zero-weight arc; the resulting
+	// shortest path distances form a potential function that eliminates negative weights.
+	augmented := make([]gogl.WeightedArc, len(arcs), len(arcs)+n)
+	copy(augmented, arcs)
+	for _, v := range ap.vertices {
+		augmented = append(augmented, gogl.NewWeightedArc(johnsonSource{}, v, 0))
+	}
+
+	h, pred, cyclic, found := bellmanFord(augmented, n+1, johnsonSource{})
+	if found {
+		return nil, NegativeCycleError{Cycle: negativeCycle(pred, n+1, cyclic)}
+	}
+
+	adj := make([][]indexedArc, n)
+	for _, a := range arcs {
+		u, v := a.Both()
+		// Reweighted values are non-negative in exact arithmetic; clamp away float error.
+		w := math.Max(0, a.Weight()+h[u]-h[v])
+		adj[ap.index[u]] = append(adj[ap.index[u]], indexedArc{to: ap.index[v], w: w})
+	}
+
+	for i := 0; i < n; i++ {
+		dijkstraIndexed(adj, i, ap.dist[i], ap.pred[i])
+
+		// Undo the reweighting to recover true distances.
+		hi := h[ap.vertices[i]]
+		for j, d := range ap.dist[i] {
+			if ap.pred[i][j] != -1 {
+				ap.dist[i][j] = d - hi + h[ap.vertices[j]]
+			}
+		}
+	}
+
+	return ap, nil
+}
+
+// A variant of Dijkstra that operates on dense vertex indices, writing its results
+// into the provided distance and predecessor rows.
+func dijkstraIndexed(adj [][]indexedArc, source int, dist []float64, pred []int) {
+	inf := math.Inf(1)
+	for j := range dist {
+		dist[j] = inf
+		pred[j] = -1
+	}
+	dist[source] = 0
+	pred[source] = source
+
+	done := make([]bool, len(adj))
+	h := &vheap{}
+	h.push(source, 0)
+
+	for h.Len() > 0 {
+		item := h.pop()
+		u := item.v.(int)
+		if done[u] {
+			continue
+		}
+		done[u] = true
+
+		for _, a := range adj[u] {
+			if alt := item.priority + a.w; alt < dist[a.to] {
+				dist[a.to] = alt
+				pred[a.to] = u
+				h.push(a.to, alt)
+			}
+		}
+	}
+}
@@ -0,0 +1,119 @@
+// Contains algos and logic related to finding shortest paths in weighted graphs.
+//
+// Edge weights are read by type asserting the edges produced by the graph's
+// enumerators to WeightedEdge (or WeightedArc, for digraphs). Directed graphs
+// are traversed along their arcs; undirected graphs along their edges in
+// both directions.
+//
+// Paths returned by this package follow the same convention as dfs.Search:
+// they begin with the target vertex and end with the start vertex.
+package sp
+
+import (
+	"container/heap"
+	"errors"
+
+	"github.com/sdboyer/gogl"
+)
+
+// A PredecessorTree records, for each vertex reached by a single-source shortest
+// path search, the vertex that precedes it on its shortest path from the source.
+//
+// The source vertex is recorded as its own predecessor, which marks the root of
+// the tree. Vertices that were not reached are not present.
+type PredecessorTree map[gogl.Vertex]gogl.Vertex
+
+// Returns the path from the root of the tree to the given target vertex, ordered
+// from the target back to the root.
+//
+// If the target was not reached by the search that produced the tree, the returned
+// slice is nil and an error is returned instead.
+func (t PredecessorTree) PathTo(target gogl.Vertex) ([]gogl.Vertex, error) {
+	if _, exists := t[target]; !exists {
+		return nil, errors.New("Target vertex is not reachable from the source.")
+	}
+
+	path := []gogl.Vertex{target}
+	for v := target; t[v] != v; {
+		v = t[v]
+		path = append(path, v)
+	}
+
+	return path, nil
+}
+
+// Returns the function used to enumerate the out-edges of a vertex, along with the
+// vertex at the other end and its weight: arcs in a digraph, incident edges in an
+// undirected graph.
+//
+// The enumerator returns an error if any edge it encounters is not weighted.
+func weightedNeighborEnumerator(g gogl.Graph) func(gogl.Vertex, func(gogl.Vertex, float64) bool) error {
+	if dg, ok := g.(gogl.Digraph); ok {
+		return func(v gogl.Vertex, f func(gogl.Vertex, float64) bool) (err error) {
+			dg.ArcsFrom(v, func(a gogl.Arc) bool {
+				wa, ok := a.(gogl.WeightedArc)
+				if !ok {
+					err = errors.New("Graph contains an arc that is not weighted.")
+					return true
+				}
+				return f(a.Target(), wa.Weight())
+			})
+			return
+		}
+	}
+
+	return func(v gogl.Vertex, f func(gogl.Vertex, float64) bool) (err error) {
+		g.IncidentTo(v, func(e gogl.Edge) bool {
+			we, ok := e.(gogl.WeightedEdge)
+			if !ok {
+				err = errors.New("Graph contains an edge that is not weighted.")
+				return true
+			}
+
+			u, t := e.Both()
+			if u == v {
+				return f(t, we.Weight())
+			}
+			return f(u, we.Weight())
+		})
+		return
+	}
+}
+
+// A vertex along with its priority, for use in a vheap.
+type vitem struct {
+	v        gogl.Vertex
+	priority float64
+}
+
+// A min-heap of vertices, ordered by priority. Implements heap.Interface.
+//
+// Rather than supporting a decrease-key operation, callers push a vertex again
+// with its new priority and ignore stale entries as they are popped.
+type vheap []vitem
+
+func (h vheap) Len() int { return len(h) }
+
+func (h vheap) Less(i, j int) bool { return h[i].priority < h[j].priority }
+
+func (h vheap) Swap(i, j int) { h[i], h[j] = h[j], h[i] }
+
+func (h *vheap) Push(x interface{}) {
+	*h = append(*h, x.(vitem))
+}
+
+func (h *vheap) Pop() interface{} {
+	old := *h
+	n := len(old)
+	item := old[n-1]
+	*h = old[:n-1]
+	return item
+}
+
+func (h *vheap) push(v gogl.Vertex, priority float64) {
+	heap.Push(h, vitem{v: v, priority: priority})
+}
+
+func (h *vheap) pop() vitem {
+	return heap.Pop(h).(vitem)
+}
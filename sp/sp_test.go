@@ -0,0 +1,56 @@
+package sp
+
+import (
+	"testing"
+
+	. "github.com/sdboyer/gocheck"
+	"github.com/sdboyer/gogl"
+)
+
+// Hook gocheck into the go test runner
+func Test(t *testing.T) { TestingT(t) }
+
+// The classic six-vertex example; shortest path from 1 to 5 is 1-3-6-5, with weight 20.
+var spArcSet = gogl.WeightedArcList{
+	gogl.NewWeightedArc(1, 2, 7),
+	gogl.NewWeightedArc(1, 3, 9),
+	gogl.NewWeightedArc(1, 6, 14),
+	gogl.NewWeightedArc(2, 3, 10),
+	gogl.NewWeightedArc(2, 4, 15),
+	gogl.NewWeightedArc(3, 4, 11),
+	gogl.NewWeightedArc(3, 6, 2),
+	gogl.NewWeightedArc(4, 5, 6),
+	gogl.NewWeightedArc(6, 5, 9),
+}
+
+var spEdgeSet = gogl.WeightedEdgeList{
+	gogl.NewWeightedEdge(1, 2, 7),
+	gogl.NewWeightedEdge(1, 3, 9),
+	gogl.NewWeightedEdge(1, 6, 14),
+	gogl.NewWeightedEdge(2, 3, 10),
+	gogl.NewWeightedEdge(2, 4, 15),
+	gogl.NewWeightedEdge(3, 4, 11),
+	gogl.NewWeightedEdge(3, 6, 2),
+	gogl.NewWeightedEdge(4, 5, 6),
+	gogl.NewWeightedEdge(6, 5, 9),
+}
+
+type PredecessorTreeSuite struct{}
+
+var _ = Suite(&PredecessorTreeSuite{})
+
+func (s *PredecessorTreeSuite) TestPathTo(c *C) {
+	t := PredecessorTree{"foo": "foo", "bar": "foo", "baz": "bar", "qux": "foo"}
+
+	path, err := t.PathTo("baz")
+	c.Assert(err, IsNil)
+	c.Assert(path, DeepEquals, []gogl.Vertex{"baz", "bar", "foo"})
+
+	path, err = t.PathTo("foo")
+	c.Assert(err, IsNil)
+	c.Assert(path, DeepEquals, []gogl.Vertex{"foo"})
+
+	path, err = t.PathTo("quark")
+	c.Assert(path, IsNil)
+	c.Assert(err, ErrorMatches, "Target vertex is not reachable.*")
+}
@@ -0,0 +1,213 @@
+package topo
+
+import (
+	"sort"
+
+	"github.com/sdboyer/gogl"
+	"github.com/sdboyer/gogl/components"
+)
+
+// A fixed-size set of small non-negative integers.
+type bitset []uint64
+
+func newBitset(n int) bitset {
+	return make(bitset, (n+63)/64)
+}
+
+func (b bitset) set(i int) {
+	b[i/64] |= 1 << uint(i%64)
+}
+
+func (b bitset) has(i int) bool {
+	return b[i/64]&(1<<uint(i%64)) != 0
+}
+
+func (b bitset) union(o bitset) {
+	for i := range b {
+		b[i] |= o[i]
+	}
+}
+
+// A Reachability index answers whether one vertex can reach another in a digraph,
+// in constant time.
+//
+// Building the index takes O(V + E) time to find the graph's strongly connected
+// components, plus O(C * E / 64) time to combine their reachability sets, where C is
+// the number of components; it occupies O(C^2 / 64) words of memory. The index is a
+// snapshot; it does not reflect changes made to the graph after it is built.
+type Reachability struct {
+	// The strongly connected components, in reverse topological order.
+	components [][]gogl.Vertex
+	// The index of the component each vertex belongs to.
+	membership map[gogl.Vertex]int
+	// For each component, the set of components reachable from it, including itself.
+	reach []bitset
+}
+
+// Builds a Reachability index over the provided digraph.
+func NewReachability(g gogl.Digraph) *Reachability {
+	r := &Reachability{
+		components: components.Tarjan(g),
+		membership: make(map[gogl.Vertex]int),
+	}
+
+	for i, component := range r.components {
+		for _, v := range component {
+			r.membership[v] = i
+		}
+	}
+
+	// Tarjan yields components in reverse topological order, so every component a
+	// component has arcs to is complete by the time it is reached.
+	r.reach = make([]bitset, len(r.components))
+	for i, component := range r.components {
+		r.reach[i] = newBitset(len(r.components))
+		r.reach[i].set(i)
+		for _, v := range component {
+			g.SuccessorsOf(v, func(w gogl.Vertex) (terminate bool) {
+				if j := r.membership[w]; j != i {
+					r.reach[i].union(r.reach[j])
+				}
+				return
+			})
+		}
+	}
+
+	return r
+}
+
+// Indicates whether or not there is a path from vertex u to vertex v. Every vertex
+// is considered reachable from itself.
+//
+// If either vertex was not present in the graph when the index was built, false is
+// returned.
+func (r *Reachability) Reachable(u, v gogl.Vertex) bool {
+	cu, exists := r.membership[u]
+	if !exists {
+		return false
+	}
+
+	cv, exists := r.membership[v]
+	if !exists {
+		return false
+	}
+
+	return r.reach[cu].has(cv)
+}
+
+// Calls the provided step function once for each vertex reachable from the given
+// vertex, other than the vertex itself.
+func (r *Reachability) reachableFrom(u gogl.Vertex, f gogl.VertexStep) {
+	cu := r.membership[u]
+	for j, component := range r.components {
+		if !r.reach[cu].has(j) {
+			continue
+		}
+
+		for _, v := range component {
+			if v != u && f(v) {
+				return
+			}
+		}
+	}
+}
+
+// Builds the transitive closure of the provided digraph: a graph with the same
+// vertices, and an arc from u to v iff there is a path from u to v in the original.
+//
+// Loops are never added to the closure, even for vertices that lie on a cycle.
+//
+// The closure is created from the provided GraphSpec using the provided creator
+// function; the spec is forced to be directed, and its source is replaced.
+func TransitiveClosure(g gogl.Digraph, gs gogl.GraphSpec, f func(gogl.GraphSpec) gogl.Graph) gogl.Digraph {
+	r := NewReachability(g)
+
+	src := &digraphSource{vertices: gogl.CollectVertices(g)}
+	for _, u := range src.vertices {
+		r.reachableFrom(u, func(v gogl.Vertex) (terminate bool) {
+			src.arcs = append(src.arcs, gogl.NewArc(u, v))
+			return
+		})
+	}
+
+	return gs.Directed().Using(src).Create(f).(gogl.Digraph)
+}
+
+// Builds the transitive reduction of the provided digraph: the graph with the fewest
+// arcs that has the same vertices and the same reachability as the original. Every arc
+// implied by a longer path - for example, a->c, when a->b and b->c are present - is
+// omitted.
+//
+// The graph must be acyclic; for a DAG, the transitive reduction is unique, and is a
+// subgraph of the original. If the graph contains a cycle, a nil graph is returned
+// along with a CycleError.
+//
+// The reduction is created from the provided GraphSpec using the provided creator
+// function; the spec is forced to be directed, and its source is replaced.
+func TransitiveReduction(g gogl.Digraph, gs gogl.GraphSpec, f func(gogl.GraphSpec) gogl.Graph) (gogl.Digraph, error) {
+	order, err := Sort(g, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	position := make(map[gogl.Vertex]int, len(order))
+	for i, v := range order {
+		position[v] = i
+	}
+
+	// Working backwards, each vertex's descendants are known before its predecessors'.
+	src := &digraphSource{vertices: order}
+	desc := make([]bitset, len(order))
+	for i := len(order) - 1; i >= 0; i-- {
+		desc[i] = newBitset(len(order))
+
+		var succ []int
+		g.SuccessorsOf(order[i], func(w gogl.Vertex) (terminate bool) {
+			succ = append(succ, position[w])
+			return
+		})
+
+		// Visiting successors in topological order ensures that if one successor can
+		// reach another, the former is visited first, and the arc to the latter is
+		// recognized as redundant.
+		sort.Ints(succ)
+		for _, j := range succ {
+			if desc[i].has(j) {
+				continue
+			}
+
+			src.arcs = append(src.arcs, gogl.NewArc(order[i], order[j]))
+			desc[i].set(j)
+			desc[i].union(desc[j])
+		}
+	}
+
+	return gs.Directed().Using(src).Create(f).(gogl.Digraph), nil
+}
+
+// A DigraphSource made of a vertex list and an arc list. Unlike a bare ArcList, it
+// can represent vertex isolates.
+type digraphSource struct {
+	vertices []gogl.Vertex
+	arcs     gogl.ArcList
+}
+
+func (s *digraphSource) Vertices(f gogl.VertexStep) {
+	for _, v := range s.vertices {
+		if f(v) {
+			return
+		}
+	}
+}
+
+func (s *digraphSource) Edges(f gogl.EdgeStep) {
+	s.arcs.Edges(f)
+}
+
+func (s *digraphSource) Arcs(f gogl.ArcStep) {
+	s.arcs.Arcs(f)
+}
+
+func (s *digraphSource) Order() int {
+	return len(s.vertices)
+}
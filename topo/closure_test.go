@@ -0,0 +1,147 @@
+package topo
+
+import (
+	stdrand "math/rand"
+
+	. "github.com/sdboyer/gocheck"
+	"github.com/sdboyer/gogl"
+	"github.com/sdboyer/gogl/graph/al"
+	"github.com/sdboyer/gogl/rand"
+)
+
+type ClosureSuite struct{}
+
+var _ = Suite(&ClosureSuite{})
+
+// Brute-force reachability, by breadth-first search from u.
+func reaches(g gogl.Digraph, u, v gogl.Vertex) bool {
+	seen := map[gogl.Vertex]bool{u: true}
+	queue := []gogl.Vertex{u}
+	for len(queue) > 0 {
+		x := queue[0]
+		queue = queue[1:]
+		if x == v {
+			return true
+		}
+		g.SuccessorsOf(x, func(w gogl.Vertex) (terminate bool) {
+			if !seen[w] {
+				seen[w] = true
+				queue = append(queue, w)
+			}
+			return
+		})
+	}
+	return false
+}
+
+func randomDigraph(seed int64, dag bool) gogl.Digraph {
+	src := rand.BernoulliDistribution(20, 0.12, true, true, stdrand.NewSource(seed))
+	g := gogl.Spec().Directed().Create(al.G).(gogl.MutableDigraph)
+	src.Vertices(func(v gogl.Vertex) (terminate bool) {
+		g.EnsureVertex(v)
+		return
+	})
+	src.(gogl.DigraphSource).Arcs(func(a gogl.Arc) (terminate bool) {
+		s, t := a.Both()
+		if !dag || s.(int) < t.(int) {
+			g.AddArcs(gogl.NewArc(s, t))
+		}
+		return
+	})
+	return g.(gogl.Digraph)
+}
+
+func (s *ClosureSuite) TestReachability(c *C) {
+	for seed := int64(0); seed < 10; seed++ {
+		g := randomDigraph(seed, false)
+		r := NewReachability(g)
+		vertices := gogl.CollectVertices(g)
+
+		for _, u := range vertices {
+			for _, v := range vertices {
+				c.Assert(r.Reachable(u, v), Equals, reaches(g, u, v), Commentf("seed %d, %v -> %v", seed, u, v))
+			}
+		}
+	}
+
+	g := gogl.Spec().Directed().Using(buildArcSet).Create(al.G).(gogl.Digraph)
+	r := NewReachability(g)
+	c.Assert(r.Reachable("fetch", "release"), Equals, true)
+	c.Assert(r.Reachable("release", "fetch"), Equals, false)
+	c.Assert(r.Reachable("docs", "docs"), Equals, true)
+	c.Assert(r.Reachable("docs", "nope"), Equals, false)
+	c.Assert(r.Reachable("nope", "nope"), Equals, false)
+}
+
+func (s *ClosureSuite) TestTransitiveClosure(c *C) {
+	for seed := int64(0); seed < 10; seed++ {
+		g := randomDigraph(seed, false)
+		tc := TransitiveClosure(g, gogl.Spec(), al.G)
+		c.Assert(gogl.Order(tc), Equals, gogl.Order(g))
+
+		vertices := gogl.CollectVertices(g)
+		for _, u := range vertices {
+			for _, v := range vertices {
+				expected := u != v && reaches(g, u, v)
+				c.Assert(tc.HasArc(gogl.NewArc(u, v)), Equals, expected, Commentf("seed %d, %v -> %v", seed, u, v))
+			}
+		}
+	}
+
+	// isolates are retained
+	g := gogl.Spec().Directed().Using(buildArcSet).Create(al.G)
+	g.(gogl.VertexSetMutator).EnsureVertex("isolate")
+	tc := TransitiveClosure(g.(gogl.Digraph), gogl.Spec(), al.G)
+	c.Assert(tc.HasVertex("isolate"), Equals, true)
+	c.Assert(gogl.Size(tc), Equals, 15)
+}
+
+func (s *ClosureSuite) TestTransitiveReduction(c *C) {
+	redundant := append(gogl.ArcList{
+		gogl.NewArc("fetch", "release"),
+		gogl.NewArc("configure", "test"),
+		gogl.NewArc("compile", "release"),
+	}, buildArcSet...)
+	g := gogl.Spec().Directed().Using(redundant).Create(al.G).(gogl.Digraph)
+
+	tr, err := TransitiveReduction(g, gogl.Spec(), al.G)
+	c.Assert(err, IsNil)
+	c.Assert(gogl.Order(tr), Equals, gogl.Order(g))
+	c.Assert(gogl.Size(tr), Equals, len(buildArcSet))
+	for _, a := range buildArcSet {
+		c.Assert(tr.HasArc(a), Equals, true, Commentf("arc %v", a))
+	}
+
+	// Compare against the definition: an arc is kept iff no longer path replaces it.
+	for seed := int64(0); seed < 10; seed++ {
+		g := randomDigraph(seed, true)
+		tr, err := TransitiveReduction(g, gogl.Spec(), al.G)
+		c.Assert(err, IsNil)
+
+		g.Arcs(func(a gogl.Arc) (terminate bool) {
+			u, v := a.Both()
+			implied := false
+			g.SuccessorsOf(u, func(w gogl.Vertex) (terminate bool) {
+				implied = w != v && reaches(g, w, v)
+				return implied
+			})
+			c.Assert(tr.HasArc(a), Equals, !implied, Commentf("seed %d, arc %v", seed, a))
+			return
+		})
+
+		tr.Arcs(func(a gogl.Arc) (terminate bool) {
+			c.Assert(g.HasArc(a), Equals, true)
+			return
+		})
+	}
+}
+
+func (s *ClosureSuite) TestTransitiveReductionCycle(c *C) {
+	el := append(gogl.ArcList{gogl.NewArc("release", "compile")}, buildArcSet...)
+	g := gogl.Spec().Directed().Using(el).Create(al.G).(gogl.Digraph)
+
+	tr, err := TransitiveReduction(g, gogl.Spec(), al.G)
+	c.Assert(tr, IsNil)
+	_, ok := err.(CycleError)
+	c.Assert(ok, Equals, true)
+}
@@ -0,0 +1,257 @@
+// Contains algos and logic related to the order and reachability structure of
+// graphs: cycles, topological orderings, and transitive relations.
+package topo
+
+import (
+	"github.com/sdboyer/gogl"
+)
+
+const (
+	white = iota
+	grey
+	black
+)
+
+// Indicates whether or not the provided graph contains a cycle.
+//
+// For a digraph, cycles must follow the direction of arcs. For an undirected graph,
+// a cycle must have at least three distinct vertices, unless the graph allows
+// loops or parallel edges.
+func HasCycle(g gogl.Graph) bool {
+	return FindCycle(g) != nil
+}
+
+// Finds a cycle in the provided graph, if one exists. If the graph is acyclic, nil
+// is returned.
+//
+// The cycle is returned in traversal order: each vertex is adjacent to (or, in a
+// digraph, has an arc to) the next, and the last vertex is adjacent to the first.
+// The first vertex is not repeated at the end.
+//
+// No guarantee is made as to which cycle is found, if there are several.
+func FindCycle(g gogl.Graph) []gogl.Vertex {
+	f := &cycleFinder{
+		g:      g,
+		colors: make(map[gogl.Vertex]uint),
+	}
+
+	if dg, ok := g.(gogl.Digraph); ok {
+		f.dg = dg
+	}
+
+	g.Vertices(func(v gogl.Vertex) (terminate bool) {
+		if f.colors[v] == white {
+			if f.dg != nil {
+				f.directed(v)
+			} else {
+				f.undirected(v, nil, false)
+			}
+		}
+		return f.cycle != nil
+	})
+
+	return f.cycle
+}
+
+type cycleFinder struct {
+	g      gogl.Graph
+	dg     gogl.Digraph
+	colors map[gogl.Vertex]uint
+	path   []gogl.Vertex
+	cycle  []gogl.Vertex
+}
+
+// Extracts the cycle closed by reaching v, which must be on the current path.
+func (f *cycleFinder) close(v gogl.Vertex) {
+	for i := len(f.path) - 1; i >= 0; i-- {
+		if f.path[i] == v {
+			f.cycle = append([]gogl.Vertex(nil), f.path[i:]...)
+			return
+		}
+	}
+}
+
+func (f *cycleFinder) directed(v gogl.Vertex) {
+	f.colors[v] = grey
+	f.path = append(f.path, v)
+
+	f.dg.SuccessorsOf(v, func(w gogl.Vertex) bool {
+		switch f.colors[w] {
+		case grey:
+			// back arc
+			f.close(w)
+		case white:
+			f.directed(w)
+		}
+		return f.cycle != nil
+	})
+
+	f.path = f.path[:len(f.path)-1]
+	f.colors[v] = black
+}
+
+// In an undirected graph, the edge back to the parent must not be mistaken for a
+// cycle. It is skipped exactly once, so that a parallel edge is still detected.
+func (f *cycleFinder) undirected(v, parent gogl.Vertex, hasParent bool) {
+	f.colors[v] = grey
+	f.path = append(f.path, v)
+
+	skipped := !hasParent
+	f.g.AdjacentTo(v, func(w gogl.Vertex) bool {
+		if !skipped && w == parent {
+			skipped = true
+			return false
+		}
+
+		switch f.colors[w] {
+		case grey:
+			f.close(w)
+		case white:
+			f.undirected(w, v, true)
+		}
+		return f.cycle != nil
+	})
+
+	f.path = f.path[:len(f.path)-1]
+	f.colors[v] = black
+}
+
+// ElementaryCycles enumerates every elementary cycle in the provided digraph using
+// Johnson's algorithm, passing each to the provided step function.
+//
+// An elementary cycle visits no vertex more than once. Each cycle is given in arc
+// order, in the same format as returned from FindCycle, and each is enumerated
+// exactly once. The slice passed to the step function is not reused, and may be
+// retained.
+//
+// If the step function returns true, enumeration ends.
+//
+// Johnson's algorithm runs in O((V+E)(C+1)) time, where C is the number of cycles.
+// Note that C can grow exponentially with the size of the graph.
+func ElementaryCycles(g gogl.Digraph, f func(cycle []gogl.Vertex) (terminate bool)) {
+	vertices := gogl.CollectVertices(g)
+	index := make(map[gogl.Vertex]int, len(vertices))
+	for i, v := range vertices {
+		index[v] = i
+	}
+
+	adj := make([][]int, len(vertices))
+	radj := make([][]int, len(vertices))
+	for i, v := range vertices {
+		g.SuccessorsOf(v, func(w gogl.Vertex) (terminate bool) {
+			adj[i] = append(adj[i], index[w])
+			radj[index[w]] = append(radj[index[w]], i)
+			return
+		})
+	}
+
+	j := &johnson{
+		vertices: vertices,
+		adj:      adj,
+		blocked:  make([]bool, len(vertices)),
+		blockmap: make([]map[int]bool, len(vertices)),
+		step:     f,
+	}
+
+	for s := 0; s < len(vertices) && !j.terminate; s++ {
+		// Cycles through s are confined to its strong component, in the subgraph
+		// induced by s and the vertices after it.
+		j.s = s
+		j.component = strongComponentOf(s, adj, radj)
+		for v := range j.component {
+			j.blocked[v] = false
+			j.blockmap[v] = make(map[int]bool)
+		}
+		j.circuit(s)
+	}
+}
+
+type johnson struct {
+	vertices  []gogl.Vertex
+	adj       [][]int
+	s         int
+	component map[int]bool
+	blocked   []bool
+	blockmap  []map[int]bool
+	stack     []int
+	step      func([]gogl.Vertex) bool
+	terminate bool
+}
+
+func (j *johnson) circuit(v int) (found bool) {
+	j.stack = append(j.stack, v)
+	j.blocked[v] = true
+
+	for _, w := range j.adj[v] {
+		if j.terminate {
+			break
+		}
+		if !j.component[w] {
+			continue
+		}
+
+		if w == j.s {
+			cycle := make([]gogl.Vertex, len(j.stack))
+			for i, u := range j.stack {
+				cycle[i] = j.vertices[u]
+			}
+			j.terminate = j.step(cycle)
+			found = true
+		} else if !j.blocked[w] && j.circuit(w) {
+			found = true
+		}
+	}
+
+	if found {
+		j.unblock(v)
+	} else {
+		for _, w := range j.adj[v] {
+			if j.component[w] {
+				j.blockmap[w][v] = true
+			}
+		}
+	}
+
+	j.stack = j.stack[:len(j.stack)-1]
+	return found
+}
+
+func (j *johnson) unblock(u int) {
+	j.blocked[u] = false
+	for w := range j.blockmap[u] {
+		delete(j.blockmap[u], w)
+		if j.blocked[w] {
+			j.unblock(w)
+		}
+	}
+}
+
+// Returns the strong component containing s, within the subgraph induced by the
+// vertices with index s or greater: the vertices both reachable from s, and from
+// which s is reachable.
+func strongComponentOf(s int, adj, radj [][]int) map[int]bool {
+	reach := func(adj [][]int) map[int]bool {
+		seen := map[int]bool{s: true}
+		queue := []int{s}
+		for len(queue) > 0 {
+			u := queue[0]
+			queue = queue[1:]
+			for _, w := range adj[u] {
+				if w >= s && !seen[w] {
+					seen[w] = true
+					queue = append(queue, w)
+				}
+			}
+		}
+		return seen
+	}
+
+	forward, backward := reach(adj), reach(radj)
+	for v := range forward {
+		if !backward[v] {
+			delete(forward, v)
+		}
+	}
+
+	return forward
+}
@@ -0,0 +1,160 @@
+package topo
+
+import (
+	"fmt"
+	"sort"
+	"testing"
+
+	. "github.com/sdboyer/gocheck"
+	"github.com/sdboyer/gogl"
+	"github.com/sdboyer/gogl/graph/al"
+)
+
+// Hook gocheck into the go test runner
+func Test(t *testing.T) { TestingT(t) }
+
+var dagArcSet = gogl.ArcList{
+	gogl.NewArc("foo", "bar"),
+	gogl.NewArc("bar", "baz"),
+	gogl.NewArc("foo", "baz"),
+	gogl.NewArc("baz", "qux"),
+}
+
+// Verifies that the given vertex sequence is a cycle in the graph.
+func checkCycle(c *C, g gogl.Graph, cycle []gogl.Vertex) {
+	c.Assert(len(cycle) > 0, Equals, true)
+
+	seen := make(map[gogl.Vertex]bool)
+	for i, v := range cycle {
+		c.Assert(seen[v], Equals, false)
+		seen[v] = true
+
+		next := cycle[(i+1)%len(cycle)]
+		if dg, ok := g.(gogl.Digraph); ok {
+			c.Assert(dg.HasArc(gogl.NewArc(v, next)), Equals, true)
+		} else {
+			c.Assert(g.HasEdge(gogl.NewEdge(v, next)), Equals, true)
+		}
+	}
+}
+
+type CycleSuite struct{}
+
+var _ = Suite(&CycleSuite{})
+
+func (s *CycleSuite) TestDirected(c *C) {
+	g := gogl.Spec().Directed().Mutable().Using(dagArcSet).Create(al.G).(gogl.MutableDigraph)
+	dg := g.(gogl.Digraph)
+
+	c.Assert(HasCycle(dg), Equals, false)
+	c.Assert(FindCycle(dg), IsNil)
+
+	g.AddArcs(gogl.NewArc("qux", "bar"))
+	c.Assert(HasCycle(dg), Equals, true)
+
+	cycle := FindCycle(dg)
+	c.Assert(len(cycle), Equals, 3)
+	checkCycle(c, dg, cycle)
+
+	// two-vertex cycles count in digraphs
+	pair := gogl.Spec().Directed().Using(gogl.ArcList{
+		gogl.NewArc(1, 2),
+		gogl.NewArc(2, 1),
+	}).Create(al.G)
+	c.Assert(len(FindCycle(pair)), Equals, 2)
+}
+
+func (s *CycleSuite) TestUndirected(c *C) {
+	// a tree is acyclic; the reverse edge to a parent is not a cycle
+	g := gogl.Spec().Mutable().Using(gogl.EdgeList{
+		gogl.NewEdge("a", "b"),
+		gogl.NewEdge("b", "c"),
+		gogl.NewEdge("b", "d"),
+		gogl.NewEdge("x", "y"),
+	}).Create(al.G).(gogl.MutableGraph)
+
+	c.Assert(HasCycle(g), Equals, false)
+	c.Assert(FindCycle(g), IsNil)
+
+	g.AddEdges(gogl.NewEdge("d", "a"))
+	cycle := FindCycle(g)
+	c.Assert(len(cycle), Equals, 3)
+	checkCycle(c, g, cycle)
+}
+
+// Normalizes cycles by rotating each to begin at its smallest vertex, then sorting.
+func normalizeCycles(cycles [][]gogl.Vertex) []string {
+	var out []string
+	for _, cycle := range cycles {
+		min := 0
+		for i, v := range cycle {
+			if fmt.Sprint(v) < fmt.Sprint(cycle[min]) {
+				min = i
+			}
+		}
+		rotated := append(append([]gogl.Vertex{}, cycle[min:]...), cycle[:min]...)
+		out = append(out, fmt.Sprint(rotated))
+	}
+	sort.Strings(out)
+	return out
+}
+
+func (s *CycleSuite) TestElementaryCycles(c *C) {
+	g := gogl.Spec().Directed().Using(gogl.ArcList{
+		gogl.NewArc(1, 2),
+		gogl.NewArc(2, 3),
+		gogl.NewArc(3, 1),
+		gogl.NewArc(2, 1),
+		gogl.NewArc(3, 4),
+		gogl.NewArc(4, 3),
+		gogl.NewArc(4, 5),
+		gogl.NewArc(5, 6),
+	}).Create(al.G).(gogl.Digraph)
+
+	var cycles [][]gogl.Vertex
+	ElementaryCycles(g, func(cycle []gogl.Vertex) (terminate bool) {
+		checkCycle(c, g, cycle)
+		cycles = append(cycles, cycle)
+		return
+	})
+
+	c.Assert(normalizeCycles(cycles), DeepEquals, []string{"[1 2 3]", "[1 2]", "[3 4]"})
+
+	// termination
+	var count int
+	ElementaryCycles(g, func(cycle []gogl.Vertex) bool {
+		count++
+		return true
+	})
+	c.Assert(count, Equals, 1)
+
+	// none in a DAG
+	dag := gogl.Spec().Directed().Using(dagArcSet).Create(al.G).(gogl.Digraph)
+	ElementaryCycles(dag, func(cycle []gogl.Vertex) bool {
+		c.Error("DAG should have no cycles")
+		return false
+	})
+}
+
+func (s *CycleSuite) TestElementaryCyclesComplete(c *C) {
+	// The complete digraph on n vertices has sum_{k=2..n} C(n,k)(k-1)! elementary cycles;
+	// for n = 5, that is 10 + 20 + 30 + 24 = 84.
+	var el gogl.ArcList
+	for u := 0; u < 5; u++ {
+		for v := 0; v < 5; v++ {
+			if u != v {
+				el = append(el, gogl.NewArc(u, v))
+			}
+		}
+	}
+	g := gogl.Spec().Directed().Using(el).Create(al.G).(gogl.Digraph)
+
+	seen := make(map[string]bool)
+	ElementaryCycles(g, func(cycle []gogl.Vertex) (terminate bool) {
+		key := normalizeCycles([][]gogl.Vertex{cycle})[0]
+		c.Assert(seen[key], Equals, false)
+		seen[key] = true
+		return
+	})
+	c.Assert(len(seen), Equals, 84)
+}
@@ -0,0 +1,181 @@
+package topo
+
+import (
+	"container/heap"
+	"fmt"
+	"sort"
+
+	"github.com/sdboyer/gogl"
+)
+
+// A Less function reports whether vertex u should be ordered before vertex v. It is
+// used to break ties between vertices that are otherwise equally eligible to come
+// next in an ordering, making that ordering reproducible.
+//
+// Less must describe a strict weak ordering, as with sort.Interface.
+type Less func(u, v gogl.Vertex) bool
+
+// A CycleError is returned when a topological ordering cannot be completed because
+// the graph contains a cycle.
+//
+// Remaining contains every vertex that could not be ordered: those on a cycle, and
+// those reachable from one.
+type CycleError struct {
+	Remaining []gogl.Vertex
+}
+
+func (e CycleError) Error() string {
+	return fmt.Sprintf("Cycle detected in graph; %d vertices could not be sorted.", len(e.Remaining))
+}
+
+// Performs a topological sort of the provided digraph using Kahn's algorithm.
+//
+// In the returned ordering, every vertex comes before all of its successors. Unlike
+// dfs.Toposort, the whole graph is always sorted, and sources come first.
+//
+// Where several vertices are eligible to come next, the one that comes first according
+// to the provided Less function is chosen; thus, given the same graph and Less function,
+// the ordering is always the same. If less is nil, ties are broken arbitrarily.
+//
+// If the graph contains a cycle, a CycleError is returned, along with the ordering of
+// those vertices that could be sorted.
+func Sort(g gogl.Digraph, less Less) ([]gogl.Vertex, error) {
+	indegree := inDegrees(g)
+	ready := &vheap{less: less}
+
+	for v, deg := range indegree {
+		if deg == 0 {
+			ready.vertices = append(ready.vertices, v)
+		}
+	}
+	heap.Init(ready)
+
+	sorted := make([]gogl.Vertex, 0, len(indegree))
+	for ready.Len() > 0 {
+		v := heap.Pop(ready).(gogl.Vertex)
+		sorted = append(sorted, v)
+		delete(indegree, v)
+
+		g.SuccessorsOf(v, func(w gogl.Vertex) (terminate bool) {
+			if indegree[w]--; indegree[w] == 0 {
+				heap.Push(ready, w)
+			}
+			return
+		})
+	}
+
+	if len(indegree) > 0 {
+		return sorted, CycleError{Remaining: remaining(indegree, less)}
+	}
+
+	return sorted, nil
+}
+
+// Partitions the provided digraph into generations, suitable for parallel scheduling.
+//
+// The first generation contains the graph's sources. Each subsequent generation
+// contains the vertices whose predecessors are all in earlier generations; thus,
+// all the vertices within a single generation are independent of one another. Each
+// vertex is placed in the earliest generation possible.
+//
+// Vertices within each generation are ordered according to the provided Less function,
+// or arbitrarily if less is nil.
+//
+// If the graph contains a cycle, a CycleError is returned, along with the generations
+// of those vertices that could be sorted.
+func Generations(g gogl.Digraph, less Less) ([][]gogl.Vertex, error) {
+	indegree := inDegrees(g)
+
+	var current []gogl.Vertex
+	for v, deg := range indegree {
+		if deg == 0 {
+			current = append(current, v)
+		}
+	}
+
+	var generations [][]gogl.Vertex
+	for len(current) > 0 {
+		sortVertices(current, less)
+		generations = append(generations, current)
+
+		var next []gogl.Vertex
+		for _, v := range current {
+			delete(indegree, v)
+			g.SuccessorsOf(v, func(w gogl.Vertex) (terminate bool) {
+				if indegree[w]--; indegree[w] == 0 {
+					next = append(next, w)
+				}
+				return
+			})
+		}
+		current = next
+	}
+
+	if len(indegree) > 0 {
+		return generations, CycleError{Remaining: remaining(indegree, less)}
+	}
+
+	return generations, nil
+}
+
+// Calculates the in-degree of every vertex in a single pass over the graph's arcs.
+func inDegrees(g gogl.Digraph) map[gogl.Vertex]int {
+	indegree := make(map[gogl.Vertex]int)
+
+	g.Vertices(func(v gogl.Vertex) (terminate bool) {
+		indegree[v] = 0
+		return
+	})
+
+	g.Arcs(func(a gogl.Arc) (terminate bool) {
+		indegree[a.Target()]++
+		return
+	})
+
+	return indegree
+}
+
+// Collects the unsorted vertices left over when a sort encounters a cycle.
+func remaining(indegree map[gogl.Vertex]int, less Less) []gogl.Vertex {
+	vertices := make([]gogl.Vertex, 0, len(indegree))
+	for v := range indegree {
+		vertices = append(vertices, v)
+	}
+	sortVertices(vertices, less)
+
+	return vertices
+}
+
+func sortVertices(vertices []gogl.Vertex, less Less) {
+	if less != nil {
+		sort.Sort(&vheap{vertices: vertices, less: less})
+	}
+}
+
+// A heap of vertices, ordered by a Less function. Implements heap.Interface, and
+// thereby sort.Interface.
+//
+// If less is nil, all vertices are considered equal.
+type vheap struct {
+	vertices []gogl.Vertex
+	less     Less
+}
+
+func (h *vheap) Len() int { return len(h.vertices) }
+
+func (h *vheap) Less(i, j int) bool {
+	return h.less != nil && h.less(h.vertices[i], h.vertices[j])
+}
+
+func (h *vheap) Swap(i, j int) { h.vertices[i], h.vertices[j] = h.vertices[j], h.vertices[i] }
+
+func (h *vheap) Push(x interface{}) {
+	h.vertices = append(h.vertices, x)
+}
+
+func (h *vheap) Pop() interface{} {
+	n := len(h.vertices)
+	v := h.vertices[n-1]
+	h.vertices = h.vertices[:n-1]
+	return v
+}
@@ -0,0 +1,95 @@
+package topo
+
+import (
+	. "github.com/sdboyer/gocheck"
+	"github.com/sdboyer/gogl"
+	"github.com/sdboyer/gogl/graph/al"
+)
+
+func byString(u, v gogl.Vertex) bool {
+	return u.(string) < v.(string)
+}
+
+// A small build graph: two independent roots, a diamond, and a trailing step.
+var buildArcSet = gogl.ArcList{
+	gogl.NewArc("fetch", "compile"),
+	gogl.NewArc("configure", "compile"),
+	gogl.NewArc("configure", "docs"),
+	gogl.NewArc("compile", "test"),
+	gogl.NewArc("compile", "package"),
+	gogl.NewArc("test", "release"),
+	gogl.NewArc("package", "release"),
+	gogl.NewArc("docs", "release"),
+}
+
+type KahnSuite struct{}
+
+var _ = Suite(&KahnSuite{})
+
+// Verifies every arc goes forward in the ordering.
+func checkOrder(c *C, g gogl.Digraph, order []gogl.Vertex) {
+	pos := make(map[gogl.Vertex]int)
+	for i, v := range order {
+		pos[v] = i
+	}
+	c.Assert(len(pos), Equals, gogl.Order(g))
+
+	g.Arcs(func(a gogl.Arc) (terminate bool) {
+		c.Assert(pos[a.Source()] < pos[a.Target()], Equals, true)
+		return
+	})
+}
+
+func (s *KahnSuite) TestSort(c *C) {
+	g := gogl.Spec().Directed().Using(buildArcSet).Create(al.G).(gogl.Digraph)
+
+	order, err := Sort(g, nil)
+	c.Assert(err, IsNil)
+	checkOrder(c, g, order)
+
+	order, err = Sort(g, byString)
+	c.Assert(err, IsNil)
+	c.Assert(order, DeepEquals, []gogl.Vertex{"configure", "docs", "fetch", "compile", "package", "test", "release"})
+
+	// reproducible across runs, despite random map iteration
+	for i := 0; i < 10; i++ {
+		again, _ := Sort(g, byString)
+		c.Assert(again, DeepEquals, order)
+	}
+}
+
+func (s *KahnSuite) TestGenerations(c *C) {
+	g := gogl.Spec().Directed().Using(buildArcSet).Create(al.G).(gogl.Digraph)
+
+	gens, err := Generations(g, byString)
+	c.Assert(err, IsNil)
+	c.Assert(gens, DeepEquals, [][]gogl.Vertex{
+		{"configure", "fetch"},
+		{"compile", "docs"},
+		{"package", "test"},
+		{"release"},
+	})
+
+	gens, err = Generations(g, nil)
+	c.Assert(err, IsNil)
+	c.Assert(len(gens), Equals, 4)
+}
+
+func (s *KahnSuite) TestCycle(c *C) {
+	el := append(gogl.ArcList{gogl.NewArc("release", "compile")}, buildArcSet...)
+	g := gogl.Spec().Directed().Using(el).Create(al.G).(gogl.Digraph)
+
+	expected := []gogl.Vertex{"compile", "package", "release", "test"}
+
+	order, err := Sort(g, byString)
+	c.Assert(order, DeepEquals, []gogl.Vertex{"configure", "docs", "fetch"})
+	c.Assert(err, ErrorMatches, "Cycle detected in graph; 4 vertices could not be sorted.")
+
+	ce, ok := err.(CycleError)
+	c.Assert(ok, Equals, true)
+	c.Assert(ce.Remaining, DeepEquals, expected)
+
+	gens, err := Generations(g, byString)
+	c.Assert(gens, DeepEquals, [][]gogl.Vertex{{"configure", "fetch"}, {"docs"}})
+	c.Assert(err.(CycleError).Remaining, DeepEquals, expected)
+}
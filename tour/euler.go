@@ -0,0 +1,239 @@
+// Contains algos and logic for finding walks that visit every edge or every vertex of
+// a graph: Eulerian paths and circuits, and Hamiltonian paths and cycles.
+//
+// Walks are returned as sequences of vertices. Digraphs are walked along the direction
+// of their arcs; undirected graphs along edges in either direction.
+package tour
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/sdboyer/gogl"
+)
+
+// A DegreeError is returned when no Eulerian path or circuit can exist, because the
+// degrees of some vertices do not permit one. The error message describes each of the
+// offending vertices and its degree.
+type DegreeError struct {
+	// The vertices whose degrees violate the requirements, in sorted order.
+	Vertices []gogl.Vertex
+	msg      string
+}
+
+func (e DegreeError) Error() string {
+	return e.msg
+}
+
+// Finds an Eulerian path in the provided graph using Hierholzer's algorithm: a walk
+// that traverses every edge exactly once.
+//
+// In an undirected graph, such a path exists iff either zero or two vertices have odd
+// degree, and all edges are connected; if there are two, the path runs between them. In
+// a digraph, every vertex must have equal in- and out-degree, except that the path's
+// start may have one more outgoing arc than incoming, and its end one more incoming
+// than outgoing. If the degree requirements are not met, a DegreeError is returned; if
+// they are, but the edges are not all connected, an error is also returned.
+//
+// The path is returned in walk order, from start to end, and thus contains one more
+// vertex than the graph has edges. If the graph has no edges, the path is empty.
+func EulerianPath(g gogl.Graph) ([]gogl.Vertex, error) {
+	return eulerian(g, false)
+}
+
+// Finds an Eulerian circuit in the provided graph using Hierholzer's algorithm: a closed
+// walk that traverses every edge exactly once, and ends where it began.
+//
+// In an undirected graph, such a circuit exists iff every vertex has even degree, and all
+// edges are connected. In a digraph, every vertex must have equal in- and out-degree. If
+// the degree requirements are not met, a DegreeError is returned; if they are, but the
+// edges are not all connected, an error is also returned.
+//
+// The circuit is returned in walk order. The first vertex is not repeated at the end, so
+// the circuit contains exactly as many vertices as the graph has edges. If the graph has
+// no edges, the circuit is empty.
+func EulerianCircuit(g gogl.Graph) ([]gogl.Vertex, error) {
+	circuit, err := eulerian(g, true)
+	if len(circuit) > 0 {
+		circuit = circuit[:len(circuit)-1]
+	}
+
+	return circuit, err
+}
+
+// An arc in an Eulerian walk's multigraph. Both directions of an undirected edge share
+// an id, so that using one uses the other.
+type eulerArc struct {
+	to, id int
+}
+
+func eulerian(g gogl.Graph, circuit bool) ([]gogl.Vertex, error) {
+	kind := "path"
+	if circuit {
+		kind = "circuit"
+	}
+
+	var vertices []gogl.Vertex
+	index := make(map[gogl.Vertex]int)
+	g.Vertices(func(v gogl.Vertex) (terminate bool) {
+		index[v] = len(vertices)
+		vertices = append(vertices, v)
+		return
+	})
+
+	n := len(vertices)
+	adj := make([][]eulerArc, n)
+	in := make([]int, n)
+	var size int
+
+	dg, directed := g.(gogl.Digraph)
+	if directed {
+		dg.Arcs(func(a gogl.Arc) (terminate bool) {
+			u, v := index[a.Source()], index[a.Target()]
+			adj[u] = append(adj[u], eulerArc{to: v, id: size})
+			in[v]++
+			size++
+			return
+		})
+	} else {
+		g.Edges(func(e gogl.Edge) (terminate bool) {
+			a, b := e.Both()
+			u, v := index[a], index[b]
+			adj[u] = append(adj[u], eulerArc{to: v, id: size})
+			adj[v] = append(adj[v], eulerArc{to: u, id: size})
+			size++
+			return
+		})
+	}
+
+	if size == 0 {
+		return nil, nil
+	}
+
+	start, err := eulerStart(vertices, adj, in, directed, circuit)
+	if err != nil {
+		return nil, err
+	}
+
+	// Hierholzer's algorithm: walk unused arcs until stuck, which can only happen at
+	// the end of the walk, then back up, splicing in sub-circuits as they are found.
+	used := make([]bool, size)
+	next := make([]int, n)
+	stack := []int{start}
+	var walk []gogl.Vertex
+
+	for len(stack) > 0 {
+		v := stack[len(stack)-1]
+		for next[v] < len(adj[v]) && used[adj[v][next[v]].id] {
+			next[v]++
+		}
+
+		if next[v] == len(adj[v]) {
+			stack = stack[:len(stack)-1]
+			walk = append(walk, vertices[v])
+			continue
+		}
+
+		a := adj[v][next[v]]
+		used[a.id] = true
+		stack = append(stack, a.to)
+	}
+
+	if len(walk) != size+1 {
+		return nil, fmt.Errorf("No Eulerian %s exists; the graph's edges are not all connected.", kind)
+	}
+
+	// The walk was assembled backwards.
+	for i, j := 0, len(walk)-1; i < j; i, j = i+1, j-1 {
+		walk[i], walk[j] = walk[j], walk[i]
+	}
+
+	return walk, nil
+}
+
+// Checks the degree requirements for an Eulerian walk, and selects the vertex from
+// which the walk must start.
+func eulerStart(vertices []gogl.Vertex, adj [][]eulerArc, in []int, directed, circuit bool) (int, error) {
+	kind := "path"
+	if circuit {
+		kind = "circuit"
+	}
+
+	start := -1
+	var violations []int
+	var plus, minus int
+
+	for v := range vertices {
+		out := len(adj[v])
+		if start == -1 && out > 0 {
+			start = v
+		}
+
+		if !directed {
+			if out%2 == 1 {
+				violations = append(violations, v)
+			}
+			continue
+		}
+
+		switch d := out - in[v]; {
+		case d == 0:
+		case d == 1 && !circuit:
+			plus++
+			violations = append(violations, v)
+		case d == -1 && !circuit:
+			minus++
+			violations = append(violations, v)
+		default:
+			// An imbalance no path could accommodate; ensure it is reported.
+			plus, minus = 2, 2
+			violations = append(violations, v)
+		}
+	}
+
+	var ok bool
+	if directed {
+		ok = plus == 0 && minus == 0 || plus == 1 && minus == 1
+	} else {
+		ok = len(violations) == 0 || len(violations) == 2 && !circuit
+	}
+
+	if !ok {
+		return -1, degreeError(kind, vertices, violations, adj, in, directed)
+	}
+
+	// An open path must start at its odd, or surplus outgoing, vertex.
+	for _, v := range violations {
+		if !directed || len(adj[v]) > in[v] {
+			return v, nil
+		}
+	}
+
+	return start, nil
+}
+
+func degreeError(kind string, vertices []gogl.Vertex, violations []int, adj [][]eulerArc, in []int, directed bool) error {
+	sort.Slice(violations, func(i, j int) bool {
+		return fmt.Sprint(vertices[violations[i]]) < fmt.Sprint(vertices[violations[j]])
+	})
+
+	e := DegreeError{}
+	var desc []string
+	for _, v := range violations {
+		e.Vertices = append(e.Vertices, vertices[v])
+		if directed {
+			desc = append(desc, fmt.Sprintf("%v (in %d, out %d)", vertices[v], in[v], len(adj[v])))
+		} else {
+			desc = append(desc, fmt.Sprintf("%v (%d)", vertices[v], len(adj[v])))
+		}
+	}
+
+	if directed {
+		e.msg = fmt.Sprintf("No Eulerian %s exists; %d vertices have unbalanced in- and out-degree: %s", kind, len(violations), strings.Join(desc, ", "))
+	} else {
+		e.msg = fmt.Sprintf("No Eulerian %s exists; %d vertices have odd degree: %s", kind, len(violations), strings.Join(desc, ", "))
+	}
+
+	return e
+}
@@ -0,0 +1,134 @@
+package tour
+
+import (
+	"testing"
+
+	. "github.com/sdboyer/gocheck"
+	"github.com/sdboyer/gogl"
+	"github.com/sdboyer/gogl/graph/al"
+)
+
+// Hook gocheck into the go test runner
+func Test(t *testing.T) { TestingT(t) }
+
+// Two triangles sharing vertex c: every vertex has even degree.
+var bowtieEdgeSet = gogl.EdgeList{
+	gogl.NewEdge("a", "b"),
+	gogl.NewEdge("b", "c"),
+	gogl.NewEdge("c", "a"),
+	gogl.NewEdge("c", "d"),
+	gogl.NewEdge("d", "e"),
+	gogl.NewEdge("e", "c"),
+}
+
+// Verifies that the walk uses every edge of g exactly once.
+func checkWalk(c *C, g gogl.Graph, walk []gogl.Vertex, closed bool) {
+	used := make(map[string]int)
+	steps := len(walk) - 1
+	if closed {
+		steps = len(walk)
+	}
+
+	_, directed := g.(gogl.Digraph)
+	for i := 0; i < steps; i++ {
+		u, v := walk[i], walk[(i+1)%len(walk)]
+		if directed {
+			c.Assert(g.(gogl.Digraph).HasArc(gogl.NewArc(u, v)), Equals, true, Commentf("step %v -> %v", u, v))
+		} else {
+			c.Assert(g.HasEdge(gogl.NewEdge(u, v)), Equals, true, Commentf("step %v - %v", u, v))
+			if u.(string) > v.(string) {
+				u, v = v, u
+			}
+		}
+		key := u.(string) + v.(string)
+		used[key]++
+		c.Assert(used[key], Equals, 1, Commentf("edge %v", key))
+	}
+
+	c.Assert(steps, Equals, gogl.Size(g))
+}
+
+type EulerSuite struct{}
+
+var _ = Suite(&EulerSuite{})
+
+func (s *EulerSuite) TestEulerianCircuit(c *C) {
+	g := gogl.Spec().Using(bowtieEdgeSet).Create(al.G)
+
+	circuit, err := EulerianCircuit(g)
+	c.Assert(err, IsNil)
+	c.Assert(circuit, HasLen, 6)
+	checkWalk(c, g, circuit, true)
+
+	path, err := EulerianPath(g)
+	c.Assert(err, IsNil)
+	c.Assert(path, HasLen, 7)
+	c.Assert(path[0], Equals, path[6])
+	checkWalk(c, g, path, false)
+}
+
+func (s *EulerSuite) TestEulerianPath(c *C) {
+	// Dropping c-a leaves a and c with odd degree; the path must run between them.
+	g := gogl.Spec().Using(append(append(gogl.EdgeList{}, bowtieEdgeSet[:2]...), bowtieEdgeSet[3:]...)).Create(al.G)
+
+	path, err := EulerianPath(g)
+	c.Assert(err, IsNil)
+	c.Assert(path, HasLen, 6)
+	checkWalk(c, g, path, false)
+	ends := []gogl.Vertex{path[0], path[5]}
+	c.Assert(ends, Contains, "a")
+	c.Assert(ends, Contains, "c")
+
+	_, err = EulerianCircuit(g)
+	c.Assert(err, ErrorMatches, `No Eulerian circuit exists; 2 vertices have odd degree: a \(1\), c \(3\)`)
+	c.Assert(err.(DegreeError).Vertices, DeepEquals, []gogl.Vertex{"a", "c"})
+}
+
+func (s *EulerSuite) TestEulerianDirected(c *C) {
+	g := gogl.Spec().Directed().Using(gogl.ArcList{
+		gogl.NewArc("a", "b"),
+		gogl.NewArc("b", "c"),
+		gogl.NewArc("c", "a"),
+		gogl.NewArc("a", "d"),
+	}).Create(al.G)
+
+	path, err := EulerianPath(g)
+	c.Assert(err, IsNil)
+	c.Assert(path[0], Equals, "a")
+	c.Assert(path[4], Equals, "d")
+	checkWalk(c, g, path, false)
+
+	_, err = EulerianCircuit(g)
+	c.Assert(err, ErrorMatches, `No Eulerian circuit exists; 2 vertices have unbalanced in- and out-degree: a \(in 1, out 2\), d \(in 1, out 0\)`)
+
+	g = gogl.Spec().Directed().Using(gogl.ArcList{
+		gogl.NewArc("a", "b"),
+		gogl.NewArc("a", "c"),
+	}).Create(al.G)
+	_, err = EulerianPath(g)
+	c.Assert(err, ErrorMatches, `No Eulerian path exists; 3 vertices have unbalanced in- and out-degree: a \(in 0, out 2\), b \(in 1, out 0\), c \(in 1, out 0\)`)
+}
+
+func (s *EulerSuite) TestEulerianDisconnected(c *C) {
+	g := gogl.Spec().Using(gogl.EdgeList{
+		gogl.NewEdge("a", "b"),
+		gogl.NewEdge("b", "c"),
+		gogl.NewEdge("c", "a"),
+		gogl.NewEdge("x", "y"),
+		gogl.NewEdge("y", "z"),
+		gogl.NewEdge("z", "x"),
+	}).Create(al.G)
+
+	_, err := EulerianCircuit(g)
+	c.Assert(err, ErrorMatches, "No Eulerian circuit exists; the graph's edges are not all connected.")
+
+	// vertex isolates don't matter
+	mg := gogl.Spec().Mutable().Using(bowtieEdgeSet).Create(al.G).(gogl.MutableGraph)
+	mg.EnsureVertex("isolate")
+	_, err = EulerianCircuit(mg.(gogl.Graph))
+	c.Assert(err, IsNil)
+
+	path, err := EulerianPath(gogl.Spec().Create(al.G))
+	c.Assert(err, IsNil)
+	c.Assert(path, HasLen, 0)
+}
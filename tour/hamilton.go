@@ -0,0 +1,163 @@
+package tour
+
+import (
+	"context"
+	"errors"
+
+	"github.com/sdboyer/gogl"
+)
+
+// Searches for a Hamiltonian path in the provided graph: a path that visits every
+// vertex exactly once.
+//
+// The path is returned in walk order. If no such path exists, an error is returned.
+// If the graph has no vertices, the path is empty.
+//
+// The search backtracks exhaustively, and so takes exponential time in the worst
+// case; it is practical only for small graphs. It stops early, returning the context's
+// error, if the provided context is cancelled.
+func HamiltonianPath(ctx context.Context, g gogl.Graph) ([]gogl.Vertex, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	h := newHamilton(ctx, g, false)
+	if len(h.vertices) == 0 {
+		return nil, nil
+	}
+
+	for s := range h.vertices {
+		if h.search(s) {
+			return h.result(), nil
+		}
+		if h.err != nil {
+			return nil, h.err
+		}
+	}
+
+	return nil, errors.New("No Hamiltonian path exists.")
+}
+
+// Searches for a Hamiltonian cycle in the provided graph: a cycle that visits every
+// vertex exactly once.
+//
+// The cycle is returned in walk order; the last vertex is adjacent to the first, which
+// is not repeated at the end. A cycle in an undirected graph must have at least three
+// vertices. If no such cycle exists, an error is returned.
+//
+// As with HamiltonianPath, the search takes exponential time in the worst case, and
+// stops early, returning the context's error, if the provided context is cancelled.
+func HamiltonianCycle(ctx context.Context, g gogl.Graph) ([]gogl.Vertex, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	h := newHamilton(ctx, g, true)
+
+	// Every vertex is on the cycle, so any one of them may be taken as its start.
+	if len(h.vertices) > 0 && (h.directed || len(h.vertices) >= 3) && h.search(0) {
+		return h.result(), nil
+	}
+	if h.err != nil {
+		return nil, h.err
+	}
+
+	return nil, errors.New("No Hamiltonian cycle exists.")
+}
+
+// State for a backtracking Hamiltonian search over dense vertex indices.
+type hamilton struct {
+	ctx      context.Context
+	vertices []gogl.Vertex
+	adj      [][]int
+	directed bool
+	cycle    bool
+	visited  []bool
+	path     []int
+	steps    int
+	err      error
+}
+
+func newHamilton(ctx context.Context, g gogl.Graph, cycle bool) *hamilton {
+	h := &hamilton{ctx: ctx, cycle: cycle}
+
+	index := make(map[gogl.Vertex]int)
+	g.Vertices(func(v gogl.Vertex) (terminate bool) {
+		index[v] = len(h.vertices)
+		h.vertices = append(h.vertices, v)
+		return
+	})
+
+	h.adj = make([][]int, len(h.vertices))
+	h.visited = make([]bool, len(h.vertices))
+
+	dg, directed := g.(gogl.Digraph)
+	h.directed = directed
+	for i, v := range h.vertices {
+		step := func(w gogl.Vertex) (terminate bool) {
+			if j := index[w]; j != i {
+				h.adj[i] = append(h.adj[i], j)
+			}
+			return
+		}
+
+		if directed {
+			dg.SuccessorsOf(v, step)
+		} else {
+			g.AdjacentTo(v, step)
+		}
+	}
+
+	return h
+}
+
+// Extends the current path to vertex v, reporting whether a complete path or cycle
+// was found. If the context is cancelled, h.err is set and false is returned.
+func (h *hamilton) search(v int) bool {
+	// Checking the context on every step would dominate the search's cost.
+	if h.steps++; h.steps%1024 == 0 {
+		if h.err = h.ctx.Err(); h.err != nil {
+			return false
+		}
+	}
+
+	h.visited[v] = true
+	h.path = append(h.path, v)
+
+	if len(h.path) == len(h.vertices) {
+		if !h.cycle || h.adjacent(v, h.path[0]) {
+			return true
+		}
+	} else {
+		for _, w := range h.adj[v] {
+			if !h.visited[w] && h.search(w) {
+				return true
+			}
+			if h.err != nil {
+				return false
+			}
+		}
+	}
+
+	h.visited[v] = false
+	h.path = h.path[:len(h.path)-1]
+	return false
+}
+
+func (h *hamilton) adjacent(u, v int) bool {
+	for _, w := range h.adj[u] {
+		if w == v {
+			return true
+		}
+	}
+	return false
+}
+
+func (h *hamilton) result() []gogl.Vertex {
+	path := make([]gogl.Vertex, len(h.path))
+	for i, v := range h.path {
+		path[i] = h.vertices[v]
+	}
+
+	return path
+}
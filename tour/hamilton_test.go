@@ -0,0 +1,123 @@
+package tour
+
+import (
+	"context"
+	"time"
+
+	. "github.com/sdboyer/gocheck"
+	"github.com/sdboyer/gogl"
+	"github.com/sdboyer/gogl/graph/al"
+)
+
+type HamiltonSuite struct{}
+
+var _ = Suite(&HamiltonSuite{})
+
+// Verifies that the path visits every vertex of g exactly once along edges of g.
+func checkHamiltonian(c *C, g gogl.Graph, path []gogl.Vertex, closed bool) {
+	c.Assert(path, HasLen, gogl.Order(g))
+
+	seen := make(map[gogl.Vertex]bool)
+	for i, v := range path {
+		c.Assert(seen[v], Equals, false)
+		seen[v] = true
+
+		if i == len(path)-1 && !closed {
+			break
+		}
+		w := path[(i+1)%len(path)]
+		if dg, ok := g.(gogl.Digraph); ok {
+			c.Assert(dg.HasArc(gogl.NewArc(v, w)), Equals, true, Commentf("step %v -> %v", v, w))
+		} else {
+			c.Assert(g.HasEdge(gogl.NewEdge(v, w)), Equals, true, Commentf("step %v - %v", v, w))
+		}
+	}
+}
+
+func (s *HamiltonSuite) TestHamiltonian(c *C) {
+	ctx := context.Background()
+
+	// The bowtie has a Hamiltonian path, but its cut vertex c rules out a cycle.
+	g := gogl.Spec().Using(bowtieEdgeSet).Create(al.G)
+	path, err := HamiltonianPath(ctx, g)
+	c.Assert(err, IsNil)
+	checkHamiltonian(c, g, path, false)
+
+	_, err = HamiltonianCycle(ctx, g)
+	c.Assert(err, ErrorMatches, "No Hamiltonian cycle exists.")
+
+	// a square has a cycle
+	g = gogl.Spec().Using(gogl.EdgeList{
+		gogl.NewEdge("a", "b"),
+		gogl.NewEdge("b", "c"),
+		gogl.NewEdge("c", "d"),
+		gogl.NewEdge("d", "a"),
+		gogl.NewEdge("a", "c"),
+	}).Create(al.G)
+	cycle, err := HamiltonianCycle(ctx, g)
+	c.Assert(err, IsNil)
+	checkHamiltonian(c, g, cycle, true)
+
+	// a star has neither
+	g = gogl.Spec().Using(gogl.EdgeList{
+		gogl.NewEdge("hub", "a"),
+		gogl.NewEdge("hub", "b"),
+		gogl.NewEdge("hub", "c"),
+	}).Create(al.G)
+	_, err = HamiltonianPath(ctx, g)
+	c.Assert(err, ErrorMatches, "No Hamiltonian path exists.")
+
+	// a single edge is a path, but not a cycle
+	g = gogl.Spec().Using(gogl.EdgeList{gogl.NewEdge("a", "b")}).Create(al.G)
+	_, err = HamiltonianCycle(ctx, g)
+	c.Assert(err, NotNil)
+}
+
+func (s *HamiltonSuite) TestHamiltonianDirected(c *C) {
+	ctx := context.Background()
+	g := gogl.Spec().Directed().Using(gogl.ArcList{
+		gogl.NewArc("a", "b"),
+		gogl.NewArc("b", "c"),
+		gogl.NewArc("c", "d"),
+		gogl.NewArc("d", "b"),
+	}).Create(al.G)
+
+	path, err := HamiltonianPath(ctx, g)
+	c.Assert(err, IsNil)
+	c.Assert(path, DeepEquals, []gogl.Vertex{"a", "b", "c", "d"})
+
+	_, err = HamiltonianCycle(ctx, g)
+	c.Assert(err, NotNil)
+
+	// two vertices with arcs both ways form a directed cycle
+	g = gogl.Spec().Directed().Using(gogl.ArcList{
+		gogl.NewArc("a", "b"),
+		gogl.NewArc("b", "a"),
+	}).Create(al.G)
+	cycle, err := HamiltonianCycle(ctx, g)
+	c.Assert(err, IsNil)
+	checkHamiltonian(c, g, cycle, true)
+}
+
+func (s *HamiltonSuite) TestHamiltonianCancel(c *C) {
+	// A complete graph plus an isolated vertex has no Hamiltonian path, but proving
+	// it requires trying every ordering of the complete graph's vertices.
+	mg := gogl.Spec().Mutable().Create(al.G).(gogl.MutableGraph)
+	for i := 0; i < 12; i++ {
+		for j := 0; j < i; j++ {
+			mg.AddEdges(gogl.NewEdge(i, j))
+		}
+	}
+	mg.EnsureVertex("isolate")
+	g := mg.(gogl.Graph)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err := HamiltonianPath(ctx, g)
+	c.Assert(err, Equals, context.Canceled)
+
+	ctx, cancel = context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	_, err = HamiltonianPath(ctx, g)
+	c.Assert(err, Equals, context.DeadlineExceeded)
+}